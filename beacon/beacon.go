@@ -0,0 +1,103 @@
+package beacon
+
+import (
+    "math/big"
+    "time"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+)
+
+
+// Settings
+const FarFutureEpoch uint64 = 0xffffffffffffffff
+
+
+// Validator statuses, as defined by the standard Beacon Node API
+type ValidatorStatus string
+const (
+    ValidatorPendingInitialized ValidatorStatus = "pending_initialized"
+    ValidatorPendingQueued ValidatorStatus = "pending_queued"
+    ValidatorActiveOngoing ValidatorStatus = "active_ongoing"
+    ValidatorActiveExiting ValidatorStatus = "active_exiting"
+    ValidatorActiveSlashed ValidatorStatus = "active_slashed"
+    ValidatorExitedUnslashed ValidatorStatus = "exited_unslashed"
+    ValidatorExitedSlashed ValidatorStatus = "exited_slashed"
+    ValidatorWithdrawalPossible ValidatorStatus = "withdrawal_possible"
+    ValidatorWithdrawalDone ValidatorStatus = "withdrawal_done"
+)
+
+
+// Check whether a validator status is active
+func (s ValidatorStatus) IsActive() bool {
+    return s == ValidatorActiveOngoing || s == ValidatorActiveExiting || s == ValidatorActiveSlashed
+}
+
+
+// Check whether a validator status is exited
+func (s ValidatorStatus) IsExited() bool {
+    return s == ValidatorExitedUnslashed || s == ValidatorExitedSlashed || s == ValidatorWithdrawalPossible || s == ValidatorWithdrawalDone
+}
+
+
+// Beacon chain config
+type Config struct {
+    GenesisTime time.Time       `json:"genesisTime"`
+    SecondsPerSlot uint64       `json:"secondsPerSlot"`
+    SlotsPerEpoch uint64        `json:"slotsPerEpoch"`
+}
+
+
+// Get the epoch at a time
+// Times before genesis are at epoch 0
+func (c Config) EpochAt(t time.Time) uint64 {
+    if !t.After(c.GenesisTime) || c.SecondsPerSlot == 0 || c.SlotsPerEpoch == 0 { return 0 }
+    slot := uint64(t.Sub(c.GenesisTime) / time.Second) / c.SecondsPerSlot
+    return slot / c.SlotsPerEpoch
+}
+
+
+// Get the start time of an epoch
+func (c Config) EpochTime(epoch uint64) time.Time {
+    return c.GenesisTime.Add(time.Duration(epoch * c.SlotsPerEpoch * c.SecondsPerSlot) * time.Second)
+}
+
+
+// Beacon chain head
+type ChainHead struct {
+    Slot uint64                 `json:"slot"`
+    Epoch uint64                `json:"epoch"`
+    JustifiedEpoch uint64       `json:"justifiedEpoch"`
+    FinalizedEpoch uint64       `json:"finalizedEpoch"`
+}
+
+
+// Validator details at an epoch
+// Balances are in wei; Exists is false for validators not yet known to the beacon chain
+type Validator struct {
+    Pubkey rptypes.ValidatorPubkey  `json:"pubkey"`
+    Exists bool                     `json:"exists"`
+    Index uint64                    `json:"index"`
+    Status ValidatorStatus          `json:"status"`
+    Balance *big.Int                `json:"balance"`
+    EffectiveBalance *big.Int       `json:"effectiveBalance"`
+    Slashed bool                    `json:"slashed"`
+    ActivationEpoch uint64          `json:"activationEpoch"`
+    ExitEpoch uint64                `json:"exitEpoch"`
+    WithdrawableEpoch uint64        `json:"withdrawableEpoch"`
+}
+
+
+// Beacon chain client
+type Client interface {
+    GetConfig() (Config, error)
+    GetChainHead() (ChainHead, error)
+    GetValidator(pubkey rptypes.ValidatorPubkey, epoch uint64) (Validator, error)
+    GetValidators(pubkeys []rptypes.ValidatorPubkey, epoch uint64) (map[rptypes.ValidatorPubkey]Validator, error)
+}
+
+
+// Convert a gwei amount to wei
+func gweiToWei(gwei uint64) *big.Int {
+    return new(big.Int).Mul(new(big.Int).SetUint64(gwei), big.NewInt(1e9))
+}
+
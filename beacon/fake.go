@@ -0,0 +1,104 @@
+package beacon
+
+import (
+    "sort"
+    "sync"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+)
+
+
+// In-memory beacon chain client for testing
+// Validator states are set from an epoch onwards and returned for that and all later epochs until replaced
+type FakeClient struct {
+    config Config
+    head ChainHead
+    validators map[rptypes.ValidatorPubkey][]fakeValidatorState
+    lock sync.RWMutex
+}
+type fakeValidatorState struct {
+    epoch uint64
+    validator Validator
+}
+
+
+// Create a new fake beacon chain client
+func NewFakeClient(config Config) *FakeClient {
+    return &FakeClient{
+        config: config,
+        validators: make(map[rptypes.ValidatorPubkey][]fakeValidatorState),
+    }
+}
+
+
+// Set the beacon chain head
+func (c *FakeClient) SetChainHead(head ChainHead) {
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    c.head = head
+}
+
+
+// Set a validator's details from an epoch onwards
+// The validator's pubkey and exists flag are set automatically
+func (c *FakeClient) SetValidator(epoch uint64, validator Validator) {
+    c.lock.Lock()
+    defer c.lock.Unlock()
+    validator.Exists = true
+    states := c.validators[validator.Pubkey]
+    for si, state := range states {
+        if state.epoch == epoch {
+            states[si].validator = validator
+            return
+        }
+    }
+    states = append(states, fakeValidatorState{epoch: epoch, validator: validator})
+    sort.Slice(states, func(i, j int) bool { return states[i].epoch < states[j].epoch })
+    c.validators[validator.Pubkey] = states
+}
+
+
+// Get the beacon chain config
+func (c *FakeClient) GetConfig() (Config, error) {
+    return c.config, nil
+}
+
+
+// Get the beacon chain head
+func (c *FakeClient) GetChainHead() (ChainHead, error) {
+    c.lock.RLock()
+    defer c.lock.RUnlock()
+    return c.head, nil
+}
+
+
+// Get a validator's details at an epoch
+func (c *FakeClient) GetValidator(pubkey rptypes.ValidatorPubkey, epoch uint64) (Validator, error) {
+    c.lock.RLock()
+    defer c.lock.RUnlock()
+    return c.getValidator(pubkey, epoch), nil
+}
+
+
+// Get validators' details at an epoch
+func (c *FakeClient) GetValidators(pubkeys []rptypes.ValidatorPubkey, epoch uint64) (map[rptypes.ValidatorPubkey]Validator, error) {
+    c.lock.RLock()
+    defer c.lock.RUnlock()
+    validators := make(map[rptypes.ValidatorPubkey]Validator)
+    for _, pubkey := range pubkeys {
+        validators[pubkey] = c.getValidator(pubkey, epoch)
+    }
+    return validators, nil
+}
+
+
+// Get the latest validator state at or before an epoch
+func (c *FakeClient) getValidator(pubkey rptypes.ValidatorPubkey, epoch uint64) Validator {
+    validator := Validator{Pubkey: pubkey}
+    for _, state := range c.validators[pubkey] {
+        if state.epoch > epoch { break }
+        validator = state.validator
+    }
+    return validator
+}
+
@@ -0,0 +1,322 @@
+package beacon
+
+import (
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "net/http"
+    "net/url"
+    "strconv"
+    "strings"
+    "sync"
+    "time"
+
+    "golang.org/x/sync/errgroup"
+
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+)
+
+
+// Settings
+const (
+    ValidatorBatchSize = 64
+    DefaultRequestTimeout = 30 * time.Second
+)
+
+
+// API paths
+const (
+    genesisPath = "/eth/v1/beacon/genesis"
+    specPath = "/eth/v1/config/spec"
+    headerPath = "/eth/v1/beacon/headers/head"
+    finalityCheckpointsPath = "/eth/v1/beacon/states/head/finality_checkpoints"
+    validatorsPath = "/eth/v1/beacon/states/%d/validators"
+)
+
+
+// Beacon chain client using the standard Beacon Node API over HTTP
+// The chain config is loaded on first use and cached
+type HTTPClient struct {
+    providerAddress string
+    client *http.Client
+    config *Config
+    configLock sync.Mutex
+}
+
+
+// Create a new HTTP beacon chain client for a beacon node provider address, e.g. "http://localhost:5052"
+func NewHTTPClient(providerAddress string) *HTTPClient {
+    return &HTTPClient{
+        providerAddress: strings.TrimSuffix(providerAddress, "/"),
+        client: &http.Client{Timeout: DefaultRequestTimeout},
+    }
+}
+
+
+// Get the beacon chain config
+func (c *HTTPClient) GetConfig() (Config, error) {
+    c.configLock.Lock()
+    defer c.configLock.Unlock()
+    if c.config != nil { return *c.config, nil }
+
+    // Data
+    var wg errgroup.Group
+    var genesis genesisResponse
+    var spec specResponse
+
+    // Load data
+    wg.Go(func() error {
+        return c.getRequest(genesisPath, &genesis)
+    })
+    wg.Go(func() error {
+        return c.getRequest(specPath, &spec)
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return Config{}, fmt.Errorf("Could not get beacon chain config: %w", err)
+    }
+
+    // Cache & return
+    config := Config{
+        GenesisTime: time.Unix(int64(genesis.Data.GenesisTime), 0),
+        SecondsPerSlot: uint64(spec.Data.SecondsPerSlot),
+        SlotsPerEpoch: uint64(spec.Data.SlotsPerEpoch),
+    }
+    c.config = &config
+    return config, nil
+
+}
+
+
+// Get the beacon chain head
+func (c *HTTPClient) GetChainHead() (ChainHead, error) {
+
+    // Data
+    var wg errgroup.Group
+    var config Config
+    var header headerResponse
+    var checkpoints finalityCheckpointsResponse
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        config, err = c.GetConfig()
+        return err
+    })
+    wg.Go(func() error {
+        return c.getRequest(headerPath, &header)
+    })
+    wg.Go(func() error {
+        return c.getRequest(finalityCheckpointsPath, &checkpoints)
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return ChainHead{}, fmt.Errorf("Could not get beacon chain head: %w", err)
+    }
+
+    // Return
+    slot := uint64(header.Data.Header.Message.Slot)
+    var epoch uint64
+    if config.SlotsPerEpoch > 0 { epoch = slot / config.SlotsPerEpoch }
+    return ChainHead{
+        Slot: slot,
+        Epoch: epoch,
+        JustifiedEpoch: uint64(checkpoints.Data.CurrentJustified.Epoch),
+        FinalizedEpoch: uint64(checkpoints.Data.Finalized.Epoch),
+    }, nil
+
+}
+
+
+// Get a validator's details at an epoch
+func (c *HTTPClient) GetValidator(pubkey rptypes.ValidatorPubkey, epoch uint64) (Validator, error) {
+    validators, err := c.GetValidators([]rptypes.ValidatorPubkey{pubkey}, epoch)
+    if err != nil {
+        return Validator{}, err
+    }
+    return validators[pubkey], nil
+}
+
+
+// Get validators' details at an epoch
+// Validators not yet known to the beacon chain are included with Exists set to false
+func (c *HTTPClient) GetValidators(pubkeys []rptypes.ValidatorPubkey, epoch uint64) (map[rptypes.ValidatorPubkey]Validator, error) {
+
+    // Get epoch start slot
+    config, err := c.GetConfig()
+    if err != nil {
+        return map[rptypes.ValidatorPubkey]Validator{}, err
+    }
+    path := fmt.Sprintf(validatorsPath, epoch * config.SlotsPerEpoch)
+
+    // Load validators in batches
+    batches := make([][]validatorResponseData, (len(pubkeys) + ValidatorBatchSize - 1) / ValidatorBatchSize)
+    var wg errgroup.Group
+    for bsi := 0; bsi < len(pubkeys); bsi += ValidatorBatchSize {
+
+        // Get batch start & end index
+        vsi := bsi
+        vei := bsi + ValidatorBatchSize
+        if vei > len(pubkeys) { vei = len(pubkeys) }
+
+        // Load validators
+        bi := bsi / ValidatorBatchSize
+        wg.Go(func() error {
+            ids := make([]string, vei - vsi)
+            for vi := vsi; vi < vei; vi++ {
+                ids[vi - vsi] = "0x" + pubkeys[vi].Hex()
+            }
+            var response validatorsResponse
+            if err := c.getRequest(path + "?id=" + url.QueryEscape(strings.Join(ids, ",")), &response); err != nil {
+                return err
+            }
+            batches[bi] = response.Data
+            return nil
+        })
+
+    }
+    if err := wg.Wait(); err != nil {
+        return map[rptypes.ValidatorPubkey]Validator{}, fmt.Errorf("Could not get validators at epoch %d: %w", epoch, err)
+    }
+
+    // Build validator map
+    validators := make(map[rptypes.ValidatorPubkey]Validator)
+    for _, pubkey := range pubkeys {
+        validators[pubkey] = Validator{Pubkey: pubkey}
+    }
+    for _, batch := range batches {
+        for _, data := range batch {
+            pubkey := rptypes.ValidatorPubkey(data.Validator.Pubkey)
+            if _, ok := validators[pubkey]; !ok { continue }
+            validators[pubkey] = Validator{
+                Pubkey: pubkey,
+                Exists: true,
+                Index: uint64(data.Index),
+                Status: ValidatorStatus(data.Status),
+                Balance: gweiToWei(uint64(data.Balance)),
+                EffectiveBalance: gweiToWei(uint64(data.Validator.EffectiveBalance)),
+                Slashed: data.Validator.Slashed,
+                ActivationEpoch: uint64(data.Validator.ActivationEpoch),
+                ExitEpoch: uint64(data.Validator.ExitEpoch),
+                WithdrawableEpoch: uint64(data.Validator.WithdrawableEpoch),
+            }
+        }
+    }
+
+    // Return
+    return validators, nil
+
+}
+
+
+// Make a GET request to the beacon node and decode the JSON response
+func (c *HTTPClient) getRequest(path string, response interface{}) error {
+
+    // Send request
+    httpResponse, err := c.client.Get(c.providerAddress + path)
+    if err != nil {
+        return err
+    }
+    defer httpResponse.Body.Close()
+    body, err := ioutil.ReadAll(httpResponse.Body)
+    if err != nil {
+        return err
+    }
+
+    // Check response status
+    if httpResponse.StatusCode != http.StatusOK {
+        var apiError errorResponse
+        if err := json.Unmarshal(body, &apiError); err == nil && apiError.Message != "" {
+            return fmt.Errorf("Beacon node request %s failed with status %d: %s", path, httpResponse.StatusCode, apiError.Message)
+        }
+        return fmt.Errorf("Beacon node request %s failed with status %d", path, httpResponse.StatusCode)
+    }
+
+    // Decode response
+    if err := json.Unmarshal(body, response); err != nil {
+        return fmt.Errorf("Could not decode beacon node response to %s: %w", path, err)
+    }
+    return nil
+
+}
+
+
+// API response types
+type errorResponse struct {
+    Code int                        `json:"code"`
+    Message string                  `json:"message"`
+}
+type genesisResponse struct {
+    Data struct {
+        GenesisTime uinteger        `json:"genesis_time"`
+    }                               `json:"data"`
+}
+type specResponse struct {
+    Data struct {
+        SecondsPerSlot uinteger     `json:"SECONDS_PER_SLOT"`
+        SlotsPerEpoch uinteger      `json:"SLOTS_PER_EPOCH"`
+    }                               `json:"data"`
+}
+type headerResponse struct {
+    Data struct {
+        Header struct {
+            Message struct {
+                Slot uinteger       `json:"slot"`
+            }                       `json:"message"`
+        }                           `json:"header"`
+    }                               `json:"data"`
+}
+type finalityCheckpointsResponse struct {
+    Data struct {
+        CurrentJustified checkpoint `json:"current_justified"`
+        Finalized checkpoint        `json:"finalized"`
+    }                               `json:"data"`
+}
+type checkpoint struct {
+    Epoch uinteger                  `json:"epoch"`
+}
+type validatorsResponse struct {
+    Data []validatorResponseData    `json:"data"`
+}
+type validatorResponseData struct {
+    Index uinteger                  `json:"index"`
+    Balance uinteger                `json:"balance"`
+    Status string                   `json:"status"`
+    Validator struct {
+        Pubkey hexPubkey                `json:"pubkey"`
+        EffectiveBalance uinteger       `json:"effective_balance"`
+        Slashed bool                    `json:"slashed"`
+        ActivationEpoch uinteger        `json:"activation_epoch"`
+        ExitEpoch uinteger              `json:"exit_epoch"`
+        WithdrawableEpoch uinteger      `json:"withdrawable_epoch"`
+    }                               `json:"validator"`
+}
+
+
+// Unsigned integer encoded as a decimal string
+type uinteger uint64
+func (i *uinteger) UnmarshalJSON(data []byte) error {
+    var dataStr string
+    if err := json.Unmarshal(data, &dataStr); err != nil { return err }
+    value, err := strconv.ParseUint(dataStr, 10, 64)
+    if err == nil { *i = uinteger(value) }
+    return err
+}
+
+
+// Validator pubkey encoded as a 0x-prefixed hex string
+type hexPubkey rptypes.ValidatorPubkey
+func (b *hexPubkey) UnmarshalJSON(data []byte) error {
+    var dataStr string
+    if err := json.Unmarshal(data, &dataStr); err != nil { return err }
+    if !strings.HasPrefix(dataStr, "0x") || len(dataStr) != 2 + rptypes.ValidatorPubkeyLength * 2 {
+        return errors.New("Invalid validator pubkey encoding")
+    }
+    pubkey, err := rptypes.HexToValidatorPubkey(dataStr[2:])
+    if err == nil { *b = hexPubkey(pubkey) }
+    return err
+}
+
@@ -7,11 +7,15 @@ require (
 	github.com/dgraph-io/ristretto v0.0.3 // indirect
 	github.com/ethereum/go-ethereum v1.10.0
 	github.com/ferranbt/fastssz v0.0.0-20210120143747-11b9eff30ea9 // indirect
+	github.com/google/uuid v1.1.5 // indirect
 	github.com/minio/highwayhash v1.0.1 // indirect
 	github.com/protolambda/zssz v0.1.5 // indirect
 	github.com/prysmaticlabs/go-bitfield v0.0.0-20210121075346-fee7b721f342 // indirect
 	github.com/prysmaticlabs/go-ssz v0.0.0-20210121151755-f6208871c388
-	golang.org/x/crypto v0.0.0-20210220033148-5ea612d1eb83 // indirect
+	github.com/supranational/blst v0.3.14
+	github.com/tyler-smith/go-bip39 v1.0.1-0.20181017060643-dbb3b84ba2ef
+	golang.org/x/crypto v0.0.0-20210220033148-5ea612d1eb83
 	golang.org/x/sync v0.0.0-20200317015054-43a5402ce75a
 	golang.org/x/sys v0.0.0-20210305230114-8fe3ee5dd75b // indirect
+	golang.org/x/text v0.3.3
 )
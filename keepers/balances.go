@@ -0,0 +1,390 @@
+package keepers
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/big"
+    "sync"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    "github.com/rocket-pool/rocketpool-go/storage"
+    "github.com/rocket-pool/rocketpool-go/tokens"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Errors
+var (
+    ErrSubmitBalancesDisabled = errors.New("Submitting network balances is currently disabled")
+    ErrNotTrustedNode = errors.New("The oracle account is not a trusted node")
+)
+
+
+// Network balances at a block
+// Minipool balances are the user share of minipool balances; staking minipool balances are taken from the beacon chain where known
+type NetworkBalances struct {
+    Block uint64                    `json:"block"`
+    Epoch uint64                    `json:"epoch"`
+    DepositPool *big.Int            `json:"depositPool"`
+    RETHContract *big.Int           `json:"rethContract"`
+    Minipools *big.Int              `json:"minipools"`
+    TotalETH *big.Int               `json:"totalEth"`
+    StakingETH *big.Int             `json:"stakingEth"`
+    RETHSupply *big.Int             `json:"rethSupply"`
+}
+
+
+// Balance oracle settings
+type BalanceOracleConfig struct {
+    Interval time.Duration      // Time between checks when running continuously
+    MaxGasPrice *big.Int        // Submissions are skipped while the gas price is above this value
+    OnError func(error)         // Called with check errors when running continuously
+}
+
+
+// A record of a network balances submission
+type BalanceSubmissionRecord struct {
+    Balances NetworkBalances    `json:"balances"`
+    Time time.Time              `json:"time"`
+    TxHash common.Hash          `json:"txHash"`
+    GasUsed uint64              `json:"gasUsed"`
+    GasCost *big.Int            `json:"gasCost"`
+    Error string                `json:"error,omitempty"`
+}
+
+
+// Submits network balances as a trusted node
+type BalanceOracle struct {
+    rp *rocketpool.RocketPool
+    opts *bind.TransactOpts
+    bc beacon.Client
+    config BalanceOracleConfig
+    submitted map[uint64]bool
+    submittedLock sync.RWMutex
+    records recordHistory
+}
+
+
+// Create a new balance oracle which sends transactions with the given trusted node options
+func NewBalanceOracle(rp *rocketpool.RocketPool, opts *bind.TransactOpts, bc beacon.Client, config BalanceOracleConfig) *BalanceOracle {
+    return &BalanceOracle{
+        rp: rp,
+        opts: opts,
+        bc: bc,
+        config: config,
+        submitted: make(map[uint64]bool),
+    }
+}
+
+
+// Get the network balances at a block
+func (o *BalanceOracle) GetNetworkBalances(block uint64) (NetworkBalances, error) {
+    opts := &bind.CallOpts{BlockNumber: new(big.Int).SetUint64(block)}
+
+    // Data
+    var wg errgroup.Group
+    var depositPoolBalance *big.Int
+    var rethContractBalance *big.Int
+    var rethSupply *big.Int
+    var launchBalance *big.Int
+    var minipools []minipool.MinipoolSnapshot
+    var epoch uint64
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        depositPoolBalance, err = deposit.GetBalance(o.rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        rethContractBalance, err = tokens.GetRETHContractETHBalance(o.rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        rethSupply, err = tokens.GetRETHTotalSupply(o.rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        launchBalance, err = settings.GetMinipoolLaunchBalance(o.rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        minipools, err = minipool.GetMinipoolSnapshots(o.rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        epoch, err = getBlockEpoch(o.rp, o.bc, opts.BlockNumber)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return NetworkBalances{}, err
+    }
+
+    // Get staking validator balances
+    pubkeys := []rptypes.ValidatorPubkey{}
+    for _, mp := range minipools {
+        if mp.Exists && mp.Status.Status == rptypes.Staking { pubkeys = append(pubkeys, mp.Pubkey) }
+    }
+    validators := make(map[rptypes.ValidatorPubkey]beacon.Validator)
+    if len(pubkeys) > 0 {
+        var err error
+        validators, err = o.bc.GetValidators(pubkeys, epoch)
+        if err != nil {
+            return NetworkBalances{}, fmt.Errorf("Could not get validator balances at block %d: %w", block, err)
+        }
+    }
+
+    // Get minipool user balances
+    minipoolBalance := big.NewInt(0)
+    stakingBalance := big.NewInt(0)
+    for _, mp := range minipools {
+        if !mp.Exists { continue }
+        var validatorBalance *big.Int
+        if validator, ok := validators[mp.Pubkey]; ok && validator.Exists { validatorBalance = validator.Balance }
+        userBalance := getMinipoolUserBalance(mp, launchBalance, validatorBalance)
+        minipoolBalance.Add(minipoolBalance, userBalance)
+        if mp.Status.Status == rptypes.Staking { stakingBalance.Add(stakingBalance, userBalance) }
+    }
+
+    // Return
+    return NetworkBalances{
+        Block: block,
+        Epoch: epoch,
+        DepositPool: depositPoolBalance,
+        RETHContract: rethContractBalance,
+        Minipools: minipoolBalance,
+        TotalETH: new(big.Int).Add(new(big.Int).Add(depositPoolBalance, rethContractBalance), minipoolBalance),
+        StakingETH: stakingBalance,
+        RETHSupply: rethSupply,
+    }, nil
+
+}
+
+
+// Get the block which network balances are next due for, and whether a submission is due
+// Submission blocks are the latest block rounded down to the submission frequency
+func (o *BalanceOracle) GetSubmissionBlock(opts *bind.CallOpts) (uint64, bool, error) {
+    block, balancesBlock, err := o.getSubmissionBlocks(opts)
+    if err != nil {
+        return 0, false, err
+    }
+    return block, (block > balancesBlock), nil
+}
+
+
+// Get the block which network balances are next due for, and the block of the current network balances
+func (o *BalanceOracle) getSubmissionBlocks(opts *bind.CallOpts) (uint64, uint64, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(o.rp.Client, opts)
+    if err != nil {
+        return 0, 0, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var submitBalancesEnabled bool
+    var submitBalancesFrequency uint64
+    var balancesBlock uint64
+    var trusted bool
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        submitBalancesEnabled, err = settings.GetSubmitBalancesEnabled(o.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        submitBalancesFrequency, err = settings.GetSubmitBalancesFrequency(o.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        balancesBlock, err = network.GetBalancesBlock(o.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        trusted, err = node.GetNodeTrusted(o.rp, o.opts.From, pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return 0, 0, err
+    }
+
+    // Check submissions are enabled & oracle account is trusted
+    if !submitBalancesEnabled {
+        return 0, 0, ErrSubmitBalancesDisabled
+    }
+    if !trusted {
+        return 0, 0, ErrNotTrustedNode
+    }
+
+    // Get submission block
+    block := pinnedOpts.BlockNumber.Uint64()
+    if submitBalancesFrequency > 0 { block -= block % submitBalancesFrequency }
+    return block, balancesBlock, nil
+
+}
+
+
+// Check for a due balances submission and submit network balances
+// Returns a record of the submission attempted, if any
+func (o *BalanceOracle) Run() ([]BalanceSubmissionRecord, error) {
+
+    // Get submission block & prune submissions for blocks with network balances
+    block, balancesBlock, err := o.getSubmissionBlocks(nil)
+    if err != nil {
+        return []BalanceSubmissionRecord{}, err
+    }
+    o.pruneSubmitted(balancesBlock)
+    if block <= balancesBlock || o.isSubmitted(block) {
+        return []BalanceSubmissionRecord{}, nil
+    }
+
+    // Get gas price
+    gasPrice, err := getGasPrice(o.rp, o.opts, o.config.MaxGasPrice)
+    if err != nil {
+        return []BalanceSubmissionRecord{}, err
+    }
+
+    // Get network balances
+    balances, err := o.GetNetworkBalances(block)
+    if err != nil {
+        return []BalanceSubmissionRecord{}, err
+    }
+
+    // Check for an existing submission from the oracle account
+    submitted, err := storage.GetBool(o.rp, getBalancesSubmissionKey(o.opts.From, balances), nil)
+    if err != nil {
+        return []BalanceSubmissionRecord{}, err
+    }
+    if submitted {
+        o.setSubmitted(block)
+        return []BalanceSubmissionRecord{}, nil
+    }
+
+    // Submit balances
+    record := BalanceSubmissionRecord{Balances: balances}
+    txReceipt, err := network.SubmitBalances(o.rp, block, balances.TotalETH, balances.StakingETH, balances.RETHSupply, getTransactOpts(o.opts, gasPrice))
+    if txReceipt != nil {
+        record.TxHash = txReceipt.TxHash
+        record.GasUsed = txReceipt.GasUsed
+        record.GasCost = new(big.Int).Mul(new(big.Int).SetUint64(txReceipt.GasUsed), gasPrice)
+    }
+    if err != nil {
+        record.Error = err.Error()
+    } else {
+        o.setSubmitted(block)
+    }
+
+    // Return
+    return []BalanceSubmissionRecord{o.addRecord(record)}, nil
+
+}
+
+
+// Check for and submit network balances at the configured interval until the context is cancelled
+func (o *BalanceOracle) Start(ctx context.Context) error {
+    return startKeeper(ctx, o.config.Interval, o.config.OnError, func(context.Context) error {
+        _, err := o.Run()
+        return err
+    })
+}
+
+
+// Get the most recent balance submission records, up to MaxRecords
+func (o *BalanceOracle) GetRecords() []BalanceSubmissionRecord {
+    stored := o.records.get()
+    records := make([]BalanceSubmissionRecord, len(stored))
+    for ri, record := range stored { records[ri] = record.(BalanceSubmissionRecord) }
+    return records
+}
+
+
+// Add a balance submission record
+func (o *BalanceOracle) addRecord(record BalanceSubmissionRecord) BalanceSubmissionRecord {
+    record.Time = time.Now()
+    o.records.add(record)
+    return record
+}
+
+
+// Check whether balances have been submitted for a block
+func (o *BalanceOracle) isSubmitted(block uint64) bool {
+    o.submittedLock.RLock()
+    defer o.submittedLock.RUnlock()
+    return o.submitted[block]
+}
+
+
+// Mark balances as submitted for a block
+func (o *BalanceOracle) setSubmitted(block uint64) {
+    o.submittedLock.Lock()
+    defer o.submittedLock.Unlock()
+    o.submitted[block] = true
+}
+
+
+// Remove submissions for blocks at or below the current network balances block, which will not be submitted for again
+func (o *BalanceOracle) pruneSubmitted(balancesBlock uint64) {
+    o.submittedLock.Lock()
+    defer o.submittedLock.Unlock()
+    for block := range o.submitted {
+        if block <= balancesBlock { delete(o.submitted, block) }
+    }
+}
+
+
+// Get the user share of a minipool's balance
+// Staking minipools without a known validator balance are valued at their user deposit balance
+func getMinipoolUserBalance(mp minipool.MinipoolSnapshot, launchBalance, validatorBalance *big.Int) *big.Int {
+    userDepositBalance := big.NewInt(0)
+    if mp.User.DepositBalance != nil { userDepositBalance.Set(mp.User.DepositBalance) }
+    switch mp.Status.Status {
+        case rptypes.Initialized, rptypes.Prelaunch:
+            return userDepositBalance
+        case rptypes.Staking:
+            if validatorBalance == nil { return userDepositBalance }
+            nodeAmount := minipool.CalculateNodeRewardAmount(mp.Node.Fee, userDepositBalance, launchBalance, validatorBalance)
+            userBalance := new(big.Int).Sub(validatorBalance, nodeAmount)
+            if userBalance.Sign() < 0 { return big.NewInt(0) }
+            return userBalance
+        case rptypes.Withdrawable:
+            if mp.WithdrawalProcessed || mp.WithdrawalTotalBalance == nil { return big.NewInt(0) }
+            userBalance := new(big.Int).Set(mp.WithdrawalTotalBalance)
+            if mp.WithdrawalNodeBalance != nil { userBalance.Sub(userBalance, mp.WithdrawalNodeBalance) }
+            return userBalance
+    }
+    return big.NewInt(0)
+}
+
+
+// Get the storage key recording a node's balances submission
+func getBalancesSubmissionKey(nodeAddress common.Address, balances NetworkBalances) common.Hash {
+    return storage.NewKey().String("network.balances.submitted.node").Address(nodeAddress).Uint(balances.Block).Uint256(balances.TotalETH).Uint256(balances.StakingETH).Uint256(balances.RETHSupply).Hash()
+}
+
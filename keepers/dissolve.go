@@ -0,0 +1,194 @@
+package keepers
+
+import (
+    "context"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Dissolver settings
+// Nil or zero limits are unbounded
+type DissolverConfig struct {
+    Interval time.Duration      // Time between scans when running continuously
+    MaxGasPrice *big.Int        // Scans are skipped while the gas price is above this value
+    MaxGasSpend *big.Int        // Maximum total gas cost in wei per scan
+    MaxDissolves int            // Maximum number of minipools dissolved per scan
+    OnError func(error)         // Called with scan errors when running continuously
+}
+
+
+// A record of a minipool dissolution attempt
+type DissolveRecord struct {
+    Minipool common.Address     `json:"minipool"`
+    Time time.Time              `json:"time"`
+    TxHash common.Hash          `json:"txHash"`
+    GasUsed uint64              `json:"gasUsed"`
+    GasCost *big.Int            `json:"gasCost"`
+    Error string                `json:"error,omitempty"`
+}
+
+
+// Dissolves minipools which have timed out in prelaunch
+type Dissolver struct {
+    rp *rocketpool.RocketPool
+    opts *bind.TransactOpts
+    config DissolverConfig
+    records recordHistory
+}
+
+
+// Create a new dissolver which sends transactions with the given options
+func NewDissolver(rp *rocketpool.RocketPool, opts *bind.TransactOpts, config DissolverConfig) *Dissolver {
+    return &Dissolver{
+        rp: rp,
+        opts: opts,
+        config: config,
+    }
+}
+
+
+// Get the addresses of minipools which have timed out in prelaunch
+func (d *Dissolver) GetTimedOutMinipools(opts *bind.CallOpts) ([]common.Address, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(d.rp.Client, opts)
+    if err != nil {
+        return []common.Address{}, err
+    }
+
+    // Get launch timeout
+    launchTimeout, err := settings.GetMinipoolLaunchTimeout(d.rp, pinnedOpts)
+    if err != nil {
+        return []common.Address{}, err
+    }
+
+    // Get prelaunch minipools
+    prelaunchMinipools, err := minipool.NewQuery().Status(rptypes.Prelaunch).SortBy(minipool.SortByStatusTime, false).Run(d.rp, pinnedOpts)
+    if err != nil {
+        return []common.Address{}, err
+    }
+
+    // Filter by dissolve eligibility
+    timedOut := []common.Address{}
+    for _, snapshot := range prelaunchMinipools {
+        actions := minipool.GetActionEligibility(minipool.LifecycleState{
+            Status: snapshot.Status.Status,
+            DepositType: snapshot.DepositType,
+            StatusBlock: snapshot.Status.StatusBlock,
+            StatusTime: snapshot.Status.StatusTime,
+            RefundBalance: snapshot.Node.RefundBalance,
+            CurrentBlock: snapshot.Block,
+            LaunchTimeout: launchTimeout,
+            CallerIsOwner: false,
+        })
+        if actions[minipool.DissolveAction].Valid {
+            timedOut = append(timedOut, snapshot.Address)
+        }
+    }
+
+    // Return
+    return timedOut, nil
+
+}
+
+
+// Scan for timed out minipools and dissolve them
+// Returns records of the dissolutions attempted during the scan
+func (d *Dissolver) Run() ([]DissolveRecord, error) {
+
+    // Get gas price
+    gasPrice, err := getGasPrice(d.rp, d.opts, d.config.MaxGasPrice)
+    if err != nil {
+        return []DissolveRecord{}, err
+    }
+
+    // Get timed out minipools
+    minipoolAddresses, err := d.GetTimedOutMinipools(nil)
+    if err != nil {
+        return []DissolveRecord{}, err
+    }
+
+    // Dissolve minipools
+    records := []DissolveRecord{}
+    gasSpent := big.NewInt(0)
+    for _, minipoolAddress := range minipoolAddresses {
+
+        // Check dissolve limit
+        if d.config.MaxDissolves > 0 && len(records) >= d.config.MaxDissolves {
+            break
+        }
+
+        // Create minipool
+        mp, err := minipool.NewMinipool(d.rp, minipoolAddress)
+        if err != nil {
+            return records, err
+        }
+
+        // Estimate gas cost & check gas spend limit
+        txOpts := getTransactOpts(d.opts, gasPrice)
+        gas, err := mp.EstimateDissolveGas(txOpts)
+        if err != nil {
+            records = append(records, d.addRecord(DissolveRecord{Minipool: minipoolAddress, Error: err.Error()}))
+            continue
+        }
+        if !withinGasSpend(gasSpent, new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice), d.config.MaxGasSpend) {
+            break
+        }
+
+        // Dissolve
+        record := DissolveRecord{Minipool: minipoolAddress}
+        txReceipt, err := mp.Dissolve(txOpts)
+        if txReceipt != nil {
+            record.TxHash = txReceipt.TxHash
+            record.GasUsed = txReceipt.GasUsed
+            record.GasCost = new(big.Int).Mul(new(big.Int).SetUint64(txReceipt.GasUsed), gasPrice)
+            gasSpent.Add(gasSpent, record.GasCost)
+        }
+        if err != nil {
+            record.Error = err.Error()
+        }
+        records = append(records, d.addRecord(record))
+
+    }
+
+    // Return
+    return records, nil
+
+}
+
+
+// Scan for and dissolve timed out minipools at the configured interval until the context is cancelled
+func (d *Dissolver) Start(ctx context.Context) error {
+    return startKeeper(ctx, d.config.Interval, d.config.OnError, func(context.Context) error {
+        _, err := d.Run()
+        return err
+    })
+}
+
+
+// Get the most recent dissolution records, up to MaxRecords
+func (d *Dissolver) GetRecords() []DissolveRecord {
+    stored := d.records.get()
+    records := make([]DissolveRecord, len(stored))
+    for ri, record := range stored { records[ri] = record.(DissolveRecord) }
+    return records
+}
+
+
+// Add a dissolution record
+func (d *Dissolver) addRecord(record DissolveRecord) DissolveRecord {
+    record.Time = time.Now()
+    d.records.add(record)
+    return record
+}
+
@@ -0,0 +1,135 @@
+package keepers
+
+import (
+    "context"
+    "errors"
+    "math/big"
+    "sync"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+
+// Settings
+const (
+    DefaultInterval = time.Minute
+    MaxRecords = 1000
+)
+
+
+// Errors
+var ErrGasPriceTooHigh = errors.New("Current gas price exceeds the maximum gas price")
+
+
+// A keeper's record history, keeping the most recent MaxRecords records
+type recordHistory struct {
+    records []interface{}
+    lock sync.RWMutex
+}
+
+
+// Add a record, dropping the oldest record if the history is full
+func (h *recordHistory) add(record interface{}) {
+    h.lock.Lock()
+    defer h.lock.Unlock()
+    if len(h.records) >= MaxRecords {
+        copy(h.records, h.records[1:])
+        h.records = h.records[:len(h.records) - 1]
+    }
+    h.records = append(h.records, record)
+}
+
+
+// Get all records in the history
+func (h *recordHistory) get() []interface{} {
+    h.lock.RLock()
+    defer h.lock.RUnlock()
+    records := make([]interface{}, len(h.records))
+    copy(records, h.records)
+    return records
+}
+
+
+// Run a keeper scan immediately and then at an interval until the context is cancelled
+// Scan errors are passed to onError if set
+func startKeeper(ctx context.Context, interval time.Duration, onError func(error), scan func(context.Context) error) error {
+    ticker := time.NewTicker(getInterval(interval))
+    defer ticker.Stop()
+    for {
+        if err := scan(ctx); err != nil && onError != nil {
+            onError(err)
+        }
+        select {
+            case <-ctx.Done():
+                return ctx.Err()
+            case <-ticker.C:
+        }
+    }
+}
+
+
+// Get the gas price to use for keeper transactions and check it against a maximum
+func getGasPrice(rp *rocketpool.RocketPool, opts *bind.TransactOpts, maxGasPrice *big.Int) (*big.Int, error) {
+
+    // Get gas price
+    gasPrice := opts.GasPrice
+    if gasPrice == nil {
+        var err error
+        gasPrice, err = rp.Client.SuggestGasPrice(context.Background())
+        if err != nil {
+            return nil, err
+        }
+    }
+
+    // Check gas price
+    if maxGasPrice != nil && gasPrice.Cmp(maxGasPrice) > 0 {
+        return nil, ErrGasPriceTooHigh
+    }
+
+    // Return
+    return gasPrice, nil
+
+}
+
+
+// Get transaction options for a single keeper transaction
+// Transactions modify their options, so a copy is made for each
+func getTransactOpts(opts *bind.TransactOpts, gasPrice *big.Int) *bind.TransactOpts {
+    txOpts := *opts
+    txOpts.GasPrice = gasPrice
+    txOpts.GasLimit = 0
+    return &txOpts
+}
+
+
+// Check whether a gas cost fits within a spend limit
+func withinGasSpend(spent, cost, maxSpend *big.Int) bool {
+    if maxSpend == nil { return true }
+    return new(big.Int).Add(spent, cost).Cmp(maxSpend) <= 0
+}
+
+
+// Get a keeper scan interval, using the default if unset
+func getInterval(interval time.Duration) time.Duration {
+    if interval <= 0 { return DefaultInterval }
+    return interval
+}
+
+
+// Get the beacon chain epoch at an eth1 block, by block time
+func getBlockEpoch(rp *rocketpool.RocketPool, bc beacon.Client, blockNumber *big.Int) (uint64, error) {
+    config, err := bc.GetConfig()
+    if err != nil {
+        return 0, err
+    }
+    header, err := rp.Client.HeaderByNumber(context.Background(), blockNumber)
+    if err != nil {
+        return 0, err
+    }
+    return config.EpochAt(time.Unix(int64(header.Time), 0)), nil
+}
+
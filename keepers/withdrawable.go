@@ -0,0 +1,286 @@
+package keepers
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    "github.com/rocket-pool/rocketpool-go/storage"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Errors
+var ErrSubmitWithdrawableDisabled = errors.New("Submitting minipool withdrawable events is currently disabled")
+
+
+// Withdrawable watchtower settings
+type WithdrawableWatchtowerConfig struct {
+    Interval time.Duration      // Time between scans when running continuously
+    MaxGasPrice *big.Int        // Scans are skipped while the gas price is above this value
+    OnError func(error)         // Called with scan errors when running continuously
+}
+
+
+// A staking minipool whose validator has exited
+// Balances are the staking start & end balances to submit; Submitted is set if the watchtower node has already submitted them
+type ExitedMinipool struct {
+    Minipool common.Address             `json:"minipool"`
+    Pubkey rptypes.ValidatorPubkey      `json:"pubkey"`
+    Status beacon.ValidatorStatus       `json:"status"`
+    StartBalance *big.Int               `json:"startBalance"`
+    EndBalance *big.Int                 `json:"endBalance"`
+    Submitted bool                      `json:"submitted"`
+}
+
+
+// A record of a minipool withdrawable submission
+type WithdrawableRecord struct {
+    ExitedMinipool
+    Time time.Time              `json:"time"`
+    TxHash common.Hash          `json:"txHash"`
+    GasUsed uint64              `json:"gasUsed"`
+    GasCost *big.Int            `json:"gasCost"`
+    Error string                `json:"error,omitempty"`
+}
+
+
+// Submits withdrawable events for staking minipools whose validators have exited, as a trusted node
+type WithdrawableWatchtower struct {
+    rp *rocketpool.RocketPool
+    opts *bind.TransactOpts
+    bc beacon.Client
+    config WithdrawableWatchtowerConfig
+    records recordHistory
+}
+
+
+// Create a new withdrawable watchtower which sends transactions with the given trusted node options
+func NewWithdrawableWatchtower(rp *rocketpool.RocketPool, opts *bind.TransactOpts, bc beacon.Client, config WithdrawableWatchtowerConfig) *WithdrawableWatchtower {
+    return &WithdrawableWatchtower{
+        rp: rp,
+        opts: opts,
+        bc: bc,
+        config: config,
+    }
+}
+
+
+// Get staking minipools whose validators have exited as of the finalized beacon chain epoch
+// Validators whose balances have already been withdrawn are not included; start balances are the validator balances at activation
+// Minipools which have already reached consensus are no longer staking and are not included
+func (w *WithdrawableWatchtower) GetExitedMinipools(opts *bind.CallOpts) ([]ExitedMinipool, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(w.rp.Client, opts)
+    if err != nil {
+        return []ExitedMinipool{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var submitWithdrawableEnabled bool
+    var trusted bool
+    var launchBalance *big.Int
+    var stakingMinipools []minipool.MinipoolSnapshot
+    var head beacon.ChainHead
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        submitWithdrawableEnabled, err = settings.GetMinipoolSubmitWithdrawableEnabled(w.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        trusted, err = node.GetNodeTrusted(w.rp, w.opts.From, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        launchBalance, err = settings.GetMinipoolLaunchBalance(w.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        stakingMinipools, err = minipool.NewQuery().Status(rptypes.Staking).Run(w.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        head, err = w.bc.GetChainHead()
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return []ExitedMinipool{}, err
+    }
+
+    // Check submissions are enabled & watchtower account is trusted
+    if !submitWithdrawableEnabled {
+        return []ExitedMinipool{}, ErrSubmitWithdrawableDisabled
+    }
+    if !trusted {
+        return []ExitedMinipool{}, ErrNotTrustedNode
+    }
+    if len(stakingMinipools) == 0 {
+        return []ExitedMinipool{}, nil
+    }
+
+    // Get validators
+    pubkeys := make([]rptypes.ValidatorPubkey, len(stakingMinipools))
+    for mi, mp := range stakingMinipools {
+        pubkeys[mi] = mp.Pubkey
+    }
+    validators, err := w.bc.GetValidators(pubkeys, head.FinalizedEpoch)
+    if err != nil {
+        return []ExitedMinipool{}, fmt.Errorf("Could not get minipool validators at epoch %d: %w", head.FinalizedEpoch, err)
+    }
+
+    // Get exited minipools
+    exited := []ExitedMinipool{}
+    activationEpochPubkeys := make(map[uint64][]rptypes.ValidatorPubkey)
+    for _, mp := range stakingMinipools {
+        validator, ok := validators[mp.Pubkey]
+        if !ok || !validator.Exists || validator.Balance == nil || validator.Balance.Sign() == 0 { continue }
+        if !validator.Status.IsExited() || validator.Status == beacon.ValidatorWithdrawalDone { continue }
+        exited = append(exited, ExitedMinipool{
+            Minipool: mp.Address,
+            Pubkey: mp.Pubkey,
+            Status: validator.Status,
+            EndBalance: validator.Balance,
+        })
+        activationEpochPubkeys[validator.ActivationEpoch] = append(activationEpochPubkeys[validator.ActivationEpoch], mp.Pubkey)
+    }
+    if len(exited) == 0 {
+        return exited, nil
+    }
+
+    // Get start balances from validators at activation
+    // Falls back to the launch balance for unknown validators, or if historical state at activation is unavailable
+    startBalances := make(map[rptypes.ValidatorPubkey]*big.Int)
+    for activationEpoch, pubkeys := range activationEpochPubkeys {
+        activationValidators, err := w.bc.GetValidators(pubkeys, activationEpoch)
+        if err != nil { continue }
+        for pubkey, validator := range activationValidators {
+            if validator.Exists && validator.Balance != nil { startBalances[pubkey] = validator.Balance }
+        }
+    }
+    for ei, mp := range exited {
+        if startBalance, ok := startBalances[mp.Pubkey]; ok {
+            exited[ei].StartBalance = startBalance
+        } else {
+            exited[ei].StartBalance = launchBalance
+        }
+    }
+
+    // Check for existing submissions from the watchtower account
+    submissionKeys := make([]common.Hash, len(exited))
+    for ei, mp := range exited {
+        submissionKeys[ei] = getWithdrawableSubmissionKey(w.opts.From, mp)
+    }
+    submitted, err := storage.GetBools(w.rp, submissionKeys, pinnedOpts)
+    if err != nil {
+        return []ExitedMinipool{}, err
+    }
+    for ei := range exited {
+        exited[ei].Submitted = submitted[ei]
+    }
+
+    // Return
+    return exited, nil
+
+}
+
+
+// Scan for exited minipools and submit their withdrawable events
+// Returns records of the submissions attempted during the scan
+func (w *WithdrawableWatchtower) Run() ([]WithdrawableRecord, error) {
+
+    // Get exited minipools
+    exited, err := w.GetExitedMinipools(nil)
+    if err != nil {
+        return []WithdrawableRecord{}, err
+    }
+    unsubmitted := []ExitedMinipool{}
+    for _, mp := range exited {
+        if !mp.Submitted { unsubmitted = append(unsubmitted, mp) }
+    }
+    if len(unsubmitted) == 0 {
+        return []WithdrawableRecord{}, nil
+    }
+
+    // Get gas price
+    gasPrice, err := getGasPrice(w.rp, w.opts, w.config.MaxGasPrice)
+    if err != nil {
+        return []WithdrawableRecord{}, err
+    }
+
+    // Submit withdrawable events
+    records := []WithdrawableRecord{}
+    for _, mp := range unsubmitted {
+        record := WithdrawableRecord{ExitedMinipool: mp}
+        txReceipt, err := minipool.SubmitMinipoolWithdrawable(w.rp, mp.Minipool, mp.StartBalance, mp.EndBalance, getTransactOpts(w.opts, gasPrice))
+        if txReceipt != nil {
+            record.TxHash = txReceipt.TxHash
+            record.GasUsed = txReceipt.GasUsed
+            record.GasCost = new(big.Int).Mul(new(big.Int).SetUint64(txReceipt.GasUsed), gasPrice)
+        }
+        if err != nil {
+            record.Error = err.Error()
+        } else {
+            record.Submitted = true
+        }
+        records = append(records, w.addRecord(record))
+    }
+
+    // Return
+    return records, nil
+
+}
+
+
+// Scan for exited minipools and submit their withdrawable events at the configured interval until the context is cancelled
+func (w *WithdrawableWatchtower) Start(ctx context.Context) error {
+    return startKeeper(ctx, w.config.Interval, w.config.OnError, func(context.Context) error {
+        _, err := w.Run()
+        return err
+    })
+}
+
+
+// Get the most recent withdrawable submission records, up to MaxRecords
+func (w *WithdrawableWatchtower) GetRecords() []WithdrawableRecord {
+    stored := w.records.get()
+    records := make([]WithdrawableRecord, len(stored))
+    for ri, record := range stored { records[ri] = record.(WithdrawableRecord) }
+    return records
+}
+
+
+// Add a withdrawable submission record
+func (w *WithdrawableWatchtower) addRecord(record WithdrawableRecord) WithdrawableRecord {
+    record.Time = time.Now()
+    w.records.add(record)
+    return record
+}
+
+
+// Get the storage key recording a node's minipool withdrawable submission
+func getWithdrawableSubmissionKey(nodeAddress common.Address, mp ExitedMinipool) common.Hash {
+    return storage.NewKey().String("minipool.withdrawable.submitted.node").Address(nodeAddress).Address(mp.Minipool).Uint256(mp.StartBalance).Uint256(mp.EndBalance).Hash()
+}
+
@@ -0,0 +1,276 @@
+package keepers
+
+import (
+    "context"
+    "errors"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Errors
+var ErrProcessWithdrawalsDisabled = errors.New("Processing withdrawals is currently disabled")
+
+
+// Withdrawal processing outcomes
+type WithdrawalOutcome string
+const (
+    WithdrawalProcessed WithdrawalOutcome = "processed"
+    WithdrawalFailed WithdrawalOutcome = "failed"
+    WithdrawalInsufficientBalance WithdrawalOutcome = "insufficientBalance"
+    WithdrawalGasLimitReached WithdrawalOutcome = "gasLimitReached"
+)
+
+
+// Withdrawal processor settings
+// Nil or zero limits are unbounded
+type WithdrawalProcessorConfig struct {
+    Interval time.Duration      // Time between scans when running continuously
+    MaxGasPrice *big.Int        // Scans are skipped while the gas price is above this value
+    MaxGasSpend *big.Int        // Maximum total gas cost in wei per scan
+    MaxWithdrawals int          // Maximum number of withdrawals processed per scan
+    MaxRetries int              // Maximum number of retries per failed withdrawal
+    RetryDelay time.Duration    // Time between retries
+    OnError func(error)         // Called with scan errors when running continuously
+}
+
+
+// A record of a withdrawal processing attempt
+type WithdrawalRecord struct {
+    Minipool common.Address             `json:"minipool"`
+    Pubkey rptypes.ValidatorPubkey      `json:"pubkey"`
+    TotalBalance *big.Int               `json:"totalBalance"`
+    Outcome WithdrawalOutcome           `json:"outcome"`
+    Time time.Time                      `json:"time"`
+    Attempts int                        `json:"attempts"`
+    TxHash common.Hash                  `json:"txHash"`
+    GasUsed uint64                      `json:"gasUsed"`
+    GasCost *big.Int                    `json:"gasCost"`
+    Error string                        `json:"error,omitempty"`
+}
+
+
+// Processes withdrawals for withdrawable minipools
+type WithdrawalProcessor struct {
+    rp *rocketpool.RocketPool
+    opts *bind.TransactOpts
+    config WithdrawalProcessorConfig
+    records recordHistory
+}
+
+
+// Create a new withdrawal processor which sends transactions with the given options
+func NewWithdrawalProcessor(rp *rocketpool.RocketPool, opts *bind.TransactOpts, config WithdrawalProcessorConfig) *WithdrawalProcessor {
+    return &WithdrawalProcessor{
+        rp: rp,
+        opts: opts,
+        config: config,
+    }
+}
+
+
+// Get minipools which are withdrawable but have not had their withdrawals processed
+func (w *WithdrawalProcessor) GetUnprocessedMinipools(opts *bind.CallOpts) ([]minipool.MinipoolDetails, error) {
+    minipools, err := minipool.GetUnprocessedMinipools(w.rp, opts)
+    if err != nil {
+        return []minipool.MinipoolDetails{}, err
+    }
+    unprocessed := []minipool.MinipoolDetails{}
+    for _, details := range minipools {
+        if details.Withdrawable && !details.WithdrawalProcessed { unprocessed = append(unprocessed, details) }
+    }
+    return unprocessed, nil
+}
+
+
+// Scan for withdrawable minipools and process their withdrawals
+// Returns records of the withdrawals attempted during the scan
+func (w *WithdrawalProcessor) Run() ([]WithdrawalRecord, error) {
+    return w.run(context.Background())
+}
+
+
+// Scan for withdrawable minipools and process their withdrawals until the context is cancelled
+func (w *WithdrawalProcessor) run(ctx context.Context) ([]WithdrawalRecord, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(w.rp.Client, nil)
+    if err != nil {
+        return []WithdrawalRecord{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var processWithdrawalsEnabled bool
+    var withdrawalBalance *big.Int
+    var unprocessed []minipool.MinipoolDetails
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        processWithdrawalsEnabled, err = settings.GetProcessWithdrawalsEnabled(w.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        withdrawalBalance, err = network.GetWithdrawalBalance(w.rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        unprocessed, err = w.GetUnprocessedMinipools(pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return []WithdrawalRecord{}, err
+    }
+
+    // Check withdrawal processing is enabled
+    if !processWithdrawalsEnabled {
+        return []WithdrawalRecord{}, ErrProcessWithdrawalsDisabled
+    }
+    if len(unprocessed) == 0 {
+        return []WithdrawalRecord{}, nil
+    }
+
+    // Get gas price
+    gasPrice, err := getGasPrice(w.rp, w.opts, w.config.MaxGasPrice)
+    if err != nil {
+        return []WithdrawalRecord{}, err
+    }
+
+    // Process withdrawals
+    records := []WithdrawalRecord{}
+    gasSpent := big.NewInt(0)
+    processed := 0
+    for _, details := range unprocessed {
+
+        // Check withdrawal limit & context
+        if w.config.MaxWithdrawals > 0 && processed >= w.config.MaxWithdrawals {
+            break
+        }
+        if ctx.Err() != nil {
+            break
+        }
+
+        // Check withdrawal pool balance
+        record := WithdrawalRecord{
+            Minipool: details.Address,
+            Pubkey: details.Pubkey,
+            TotalBalance: details.WithdrawalTotalBalance,
+        }
+        if details.WithdrawalTotalBalance != nil && withdrawalBalance.Cmp(details.WithdrawalTotalBalance) < 0 {
+            record.Outcome = WithdrawalInsufficientBalance
+            records = append(records, w.addRecord(record))
+            continue
+        }
+
+        // Process withdrawal
+        record = w.processWithdrawal(ctx, record, gasPrice, gasSpent)
+        records = append(records, w.addRecord(record))
+        if record.GasCost != nil {
+            gasSpent.Add(gasSpent, record.GasCost)
+        }
+        if record.Outcome == WithdrawalGasLimitReached {
+            break
+        }
+        if record.Outcome == WithdrawalProcessed {
+            processed++
+            if details.WithdrawalTotalBalance != nil { withdrawalBalance.Sub(withdrawalBalance, details.WithdrawalTotalBalance) }
+        }
+
+    }
+
+    // Return
+    return records, nil
+
+}
+
+
+// Scan for and process withdrawals at the configured interval until the context is cancelled
+func (w *WithdrawalProcessor) Start(ctx context.Context) error {
+    return startKeeper(ctx, w.config.Interval, w.config.OnError, func(ctx context.Context) error {
+        _, err := w.run(ctx)
+        return err
+    })
+}
+
+
+// Get the most recent withdrawal processing records, up to MaxRecords
+func (w *WithdrawalProcessor) GetRecords() []WithdrawalRecord {
+    stored := w.records.get()
+    records := make([]WithdrawalRecord, len(stored))
+    for ri, record := range stored { records[ri] = record.(WithdrawalRecord) }
+    return records
+}
+
+
+// Process a minipool withdrawal, retrying on failure until retries are exhausted or the context is cancelled
+// Gas used and gas cost are totalled across attempts
+func (w *WithdrawalProcessor) processWithdrawal(ctx context.Context, record WithdrawalRecord, gasPrice *big.Int, gasSpent *big.Int) WithdrawalRecord {
+    for record.Attempts = 1; ; record.Attempts++ {
+
+        // Estimate gas cost & check gas spend limit
+        txOpts := getTransactOpts(w.opts, gasPrice)
+        gas, err := network.EstimateProcessWithdrawalGas(w.rp, record.Pubkey, txOpts)
+        if err == nil && !withinGasSpend(gasSpent, new(big.Int).Mul(new(big.Int).SetUint64(gas), gasPrice), w.config.MaxGasSpend) {
+            record.Outcome = WithdrawalGasLimitReached
+            record.Error = ""
+            return record
+        }
+
+        // Process withdrawal
+        if err == nil {
+            txReceipt, txErr := network.ProcessWithdrawal(w.rp, record.Pubkey, txOpts)
+            if txReceipt != nil {
+                gasCost := new(big.Int).Mul(new(big.Int).SetUint64(txReceipt.GasUsed), gasPrice)
+                record.TxHash = txReceipt.TxHash
+                record.GasUsed += txReceipt.GasUsed
+                if record.GasCost == nil { record.GasCost = big.NewInt(0) }
+                record.GasCost.Add(record.GasCost, gasCost)
+                gasSpent = new(big.Int).Add(gasSpent, gasCost)
+            }
+            err = txErr
+        }
+
+        // Check result
+        if err == nil {
+            record.Outcome = WithdrawalProcessed
+            record.Error = ""
+            return record
+        }
+        record.Outcome = WithdrawalFailed
+        record.Error = err.Error()
+        if record.Attempts > w.config.MaxRetries {
+            return record
+        }
+        select {
+            case <-ctx.Done():
+                return record
+            case <-time.After(w.config.RetryDelay):
+        }
+
+    }
+}
+
+
+// Add a withdrawal processing record
+func (w *WithdrawalProcessor) addRecord(record WithdrawalRecord) WithdrawalRecord {
+    record.Time = time.Now()
+    w.records.add(record)
+    return record
+}
+
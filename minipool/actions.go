@@ -0,0 +1,270 @@
+package minipool
+
+import (
+    "bytes"
+    "encoding/json"
+    "fmt"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const EstimatedBlockTime = 13 * time.Second
+
+
+// Minipool actions
+type MinipoolAction uint8
+const (
+    RefundAction MinipoolAction = iota
+    StakeAction
+    DissolveAction
+    WithdrawAction
+    CloseAction
+)
+var MinipoolActions = []string{"Refund", "Stake", "Dissolve", "Withdraw", "Close"}
+
+
+// String conversion
+func (a MinipoolAction) String() string {
+    if int(a) >= len(MinipoolActions) { return "" }
+    return MinipoolActions[a]
+}
+
+
+// JSON encoding
+func (a MinipoolAction) MarshalJSON() ([]byte, error) {
+    str := a.String()
+    if str == "" {
+        return []byte{}, fmt.Errorf("Invalid minipool action '%d'", a)
+    }
+    return json.Marshal(str)
+}
+
+
+// Minipool lifecycle state used to determine action eligibility
+type LifecycleState struct {
+    Status rptypes.MinipoolStatus
+    DepositType rptypes.MinipoolDeposit
+    StatusBlock uint64
+    StatusTime time.Time
+    RefundBalance *big.Int
+    CurrentBlock uint64
+    LaunchTimeout uint64
+    WithdrawalDelay uint64
+    CallerIsOwner bool
+}
+
+
+// Minipool action eligibility
+// AvailableBlock & AvailableTime are set if a blocked action will become available after a delay
+// AvailableTime is estimated from the status time and EstimatedBlockTime
+type ActionEligibility struct {
+    Action MinipoolAction       `json:"action"`
+    Valid bool                  `json:"valid"`
+    Reason string               `json:"reason"`
+    AvailableBlock uint64       `json:"availableBlock,omitempty"`
+    AvailableTime time.Time     `json:"availableTime"`
+}
+
+
+// Get the eligibility of all minipool actions for a lifecycle state
+func GetActionEligibility(state LifecycleState) []ActionEligibility {
+    return []ActionEligibility{
+        getRefundEligibility(state),
+        getStakeEligibility(state),
+        getDissolveEligibility(state),
+        getWithdrawEligibility(state),
+        getCloseEligibility(state),
+    }
+}
+
+
+// Get the minipool's lifecycle state for a caller
+func (mp *Minipool) GetLifecycleState(callerAddress common.Address, opts *bind.CallOpts) (LifecycleState, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(mp.RocketPool.Client, opts)
+    if err != nil {
+        return LifecycleState{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var status StatusDetails
+    var depositType rptypes.MinipoolDeposit
+    var nodeAddress common.Address
+    var refundBalance *big.Int
+    var launchTimeout uint64
+    var withdrawalDelay uint64
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        status, err = mp.GetStatusDetails(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        depositType, err = mp.GetDepositType(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        nodeAddress, err = mp.GetNodeAddress(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        refundBalance, err = mp.GetNodeRefundBalance(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        launchTimeout, err = settings.GetMinipoolLaunchTimeout(mp.RocketPool, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        withdrawalDelay, err = settings.GetMinipoolWithdrawalDelay(mp.RocketPool, pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return LifecycleState{}, err
+    }
+
+    // Return
+    return LifecycleState{
+        Status: status.Status,
+        DepositType: depositType,
+        StatusBlock: status.StatusBlock,
+        StatusTime: status.StatusTime,
+        RefundBalance: refundBalance,
+        CurrentBlock: pinnedOpts.BlockNumber.Uint64(),
+        LaunchTimeout: launchTimeout,
+        WithdrawalDelay: withdrawalDelay,
+        CallerIsOwner: bytes.Equal(callerAddress.Bytes(), nodeAddress.Bytes()),
+    }, nil
+
+}
+
+
+// Get the eligibility of all minipool actions for a caller
+func (mp *Minipool) GetActionEligibility(callerAddress common.Address, opts *bind.CallOpts) ([]ActionEligibility, error) {
+    state, err := mp.GetLifecycleState(callerAddress, opts)
+    if err != nil {
+        return []ActionEligibility{}, err
+    }
+    return GetActionEligibility(state), nil
+}
+
+
+// Refund: node refund balance must be available
+func getRefundEligibility(state LifecycleState) ActionEligibility {
+    action := ActionEligibility{Action: RefundAction}
+    switch {
+        case !state.CallerIsOwner:
+            action.Reason = "Only the minipool owner can refund"
+        case state.DepositType == rptypes.Empty:
+            action.Reason = "Empty deposit minipools have no node deposit to refund"
+        case state.RefundBalance == nil || state.RefundBalance.Sign() <= 0:
+            action.Reason = "The minipool has no node refund balance"
+        default:
+            action.Valid = true
+            action.Reason = "The minipool has a node refund balance available"
+    }
+    return action
+}
+
+
+// Stake: minipool must be in prelaunch
+func getStakeEligibility(state LifecycleState) ActionEligibility {
+    action := ActionEligibility{Action: StakeAction}
+    switch {
+        case !state.CallerIsOwner:
+            action.Reason = "Only the minipool owner can stake"
+        case state.Status != rptypes.Prelaunch:
+            action.Reason = fmt.Sprintf("The minipool can only be staked while in prelaunch (currently %s)", state.Status.String())
+        default:
+            action.Valid = true
+            action.Reason = "The minipool is in prelaunch"
+    }
+    return action
+}
+
+
+// Dissolve: minipool must be initialized or in prelaunch, and dissolved by its owner or after timing out
+func getDissolveEligibility(state LifecycleState) ActionEligibility {
+    action := ActionEligibility{Action: DissolveAction}
+    switch {
+        case state.Status != rptypes.Initialized && state.Status != rptypes.Prelaunch:
+            action.Reason = fmt.Sprintf("The minipool can only be dissolved while initialized or in prelaunch (currently %s)", state.Status.String())
+        case state.CallerIsOwner:
+            action.Valid = true
+            action.Reason = "The minipool can be dissolved by its owner"
+        case state.Status != rptypes.Prelaunch:
+            action.Reason = "The minipool can only be dissolved by its owner unless it has timed out in prelaunch"
+        case state.CurrentBlock < state.StatusBlock + state.LaunchTimeout:
+            action.Reason = "The minipool has not yet timed out in prelaunch"
+            setAvailable(&action, state, state.StatusBlock + state.LaunchTimeout)
+        default:
+            action.Valid = true
+            action.Reason = "The minipool has timed out in prelaunch"
+    }
+    return action
+}
+
+
+// Withdraw: minipool must be withdrawable and past the withdrawal delay
+func getWithdrawEligibility(state LifecycleState) ActionEligibility {
+    action := ActionEligibility{Action: WithdrawAction}
+    switch {
+        case !state.CallerIsOwner:
+            action.Reason = "Only the minipool owner can withdraw"
+        case state.Status != rptypes.Withdrawable:
+            action.Reason = fmt.Sprintf("The minipool can only be withdrawn from while withdrawable (currently %s)", state.Status.String())
+        case state.CurrentBlock < state.StatusBlock + state.WithdrawalDelay:
+            action.Reason = "The minipool withdrawal delay has not yet passed"
+            setAvailable(&action, state, state.StatusBlock + state.WithdrawalDelay)
+        default:
+            action.Valid = true
+            action.Reason = "The minipool is withdrawable and the withdrawal delay has passed"
+    }
+    return action
+}
+
+
+// Close: minipool must be dissolved
+func getCloseEligibility(state LifecycleState) ActionEligibility {
+    action := ActionEligibility{Action: CloseAction}
+    switch {
+        case !state.CallerIsOwner:
+            action.Reason = "Only the minipool owner can close"
+        case state.Status != rptypes.Dissolved:
+            action.Reason = fmt.Sprintf("The minipool can only be closed while dissolved (currently %s)", state.Status.String())
+        default:
+            action.Valid = true
+            action.Reason = "The minipool is dissolved"
+    }
+    return action
+}
+
+
+// Set the block & estimated time at which a blocked action becomes available
+func setAvailable(action *ActionEligibility, state LifecycleState, availableBlock uint64) {
+    action.AvailableBlock = availableBlock
+    if !state.StatusTime.IsZero() {
+        action.AvailableTime = state.StatusTime.Add(time.Duration(availableBlock - state.StatusBlock) * EstimatedBlockTime)
+    }
+}
+
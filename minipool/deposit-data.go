@@ -0,0 +1,129 @@
+package minipool
+
+import (
+    "bytes"
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const DepositDataBatchSize = 20
+
+
+// A deposit data file entry matched to a prelaunch minipool
+type DepositDataMatch struct {
+    Minipool common.Address                 `json:"minipool"`
+    Entry rptypes.DepositDataFileEntry      `json:"entry"`
+}
+
+
+// Match deposit data file entries to a node's prelaunch minipools
+// Entries with incorrect withdrawal credentials or amounts, or with validator pubkeys already in use, are skipped
+// Minipools are matched in order of status time, and entries in file order
+func MatchDepositData(rp *rocketpool.RocketPool, nodeAddress common.Address, entries []rptypes.DepositDataFileEntry, opts *bind.CallOpts) ([]DepositDataMatch, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []DepositDataMatch{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var prelaunchMinipools []MinipoolSnapshot
+    var withdrawalCredentials common.Hash
+    var launchAmount uint64
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        prelaunchMinipools, err = NewQuery().Node(nodeAddress).Status(rptypes.Prelaunch).SortBy(SortByStatusTime, false).Run(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        withdrawalCredentials, launchAmount, err = getDepositParameters(rp, pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return []DepositDataMatch{}, err
+    }
+
+    // Load validator pubkey minipools in batches
+    pubkeyMinipools := make([]common.Address, len(entries))
+    for bsi := 0; bsi < len(entries); bsi += DepositDataBatchSize {
+
+        // Get batch start & end index
+        esi := bsi
+        eei := bsi + DepositDataBatchSize
+        if eei > len(entries) { eei = len(entries) }
+
+        // Load minipool addresses
+        var wg errgroup.Group
+        for ei := esi; ei < eei; ei++ {
+            ei := ei
+            wg.Go(func() error {
+                minipoolAddress, err := GetMinipoolByPubkey(rp, entries[ei].PublicKey, pinnedOpts)
+                if err == nil { pubkeyMinipools[ei] = minipoolAddress }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []DepositDataMatch{}, err
+        }
+
+    }
+
+    // Match entries to minipools
+    matches := []DepositDataMatch{}
+    matchedPubkeys := make(map[rptypes.ValidatorPubkey]bool)
+    for ei, entry := range entries {
+        if len(matches) >= len(prelaunchMinipools) {
+            break
+        }
+        if !bytes.Equal(pubkeyMinipools[ei].Bytes(), common.Address{}.Bytes()) || matchedPubkeys[entry.PublicKey] {
+            continue
+        }
+        if !bytes.Equal(entry.WithdrawalCredentials.Bytes(), withdrawalCredentials.Bytes()) || entry.Amount != launchAmount {
+            continue
+        }
+        matches = append(matches, DepositDataMatch{
+            Minipool: prelaunchMinipools[len(matches)].Address,
+            Entry: entry,
+        })
+        matchedPubkeys[entry.PublicKey] = true
+    }
+
+    // Return
+    return matches, nil
+
+}
+
+
+// Progress the prelaunch minipool to staking with a deposit data file entry
+// The entry's roots and its signature for its own fork version are checked before staking
+// Entries for a fork version other than the minipool's deposit fork version, if set, are rejected
+func (mp *Minipool) StakeWithDepositData(entry rptypes.DepositDataFileEntry, opts *bind.TransactOpts) (*types.Receipt, error) {
+    if mp.DepositForkVersion != nil && entry.ForkVersion != *mp.DepositForkVersion {
+        return nil, fmt.Errorf("Could not stake minipool %s: deposit data fork version %s does not match the deposit fork version %s", mp.Address.Hex(), entry.ForkVersion.Hex(), mp.DepositForkVersion.Hex())
+    }
+    if err := entry.CheckRoots(); err != nil {
+        return nil, fmt.Errorf("Could not stake minipool %s: %w", mp.Address.Hex(), err)
+    }
+    if err := entry.VerifySignature(entry.ForkVersion); err != nil {
+        return nil, fmt.Errorf("Could not stake minipool %s: %w", mp.Address.Hex(), err)
+    }
+    return mp.Stake(entry.PublicKey, entry.Signature, entry.DepositDataRoot, opts)
+}
+
@@ -1,6 +1,7 @@
 package minipool
 
 import (
+    "bytes"
     "fmt"
     "math/big"
     "sync"
@@ -11,7 +12,9 @@ import (
     "github.com/ethereum/go-ethereum/core/types"
     "golang.org/x/sync/errgroup"
 
+    "github.com/rocket-pool/rocketpool-go/network"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
     rptypes "github.com/rocket-pool/rocketpool-go/types"
     "github.com/rocket-pool/rocketpool-go/utils/eth"
 )
@@ -42,10 +45,12 @@ type StakingDetails struct {
 
 
 // Minipool contract
+// If DepositForkVersion is set, validator deposit signatures are verified for that beacon chain fork version before staking
 type Minipool struct {
     Address common.Address
     Contract *rocketpool.Contract
     RocketPool *rocketpool.RocketPool
+    DepositForkVersion *rptypes.ForkVersion
 }
 
 
@@ -349,8 +354,43 @@ func (mp *Minipool) Refund(opts *bind.TransactOpts) (*types.Receipt, error) {
 }
 
 
+// Estimate the gas required to refund node ETH from the minipool
+func (mp *Minipool) EstimateRefundGas(opts *bind.TransactOpts) (uint64, error) {
+    gas, err := mp.Contract.EstimateGas(opts, "refund")
+    if err != nil {
+        return 0, fmt.Errorf("Could not estimate gas to refund from minipool %s: %w", mp.Address.Hex(), err)
+    }
+    return gas, nil
+}
+
+
+// Validate validator deposit data against the network withdrawal credentials and minipool launch balance
+func (mp *Minipool) ValidateDepositData(depositData rptypes.DepositData, depositDataRoot common.Hash, opts *bind.CallOpts) error {
+
+    // Get expected deposit parameters
+    withdrawalCredentials, launchAmount, err := getDepositParameters(mp.RocketPool, opts)
+    if err != nil {
+        return err
+    }
+
+    // Check deposit amount
+    if depositData.Amount != launchAmount {
+        return fmt.Errorf("Deposit data amount %d does not match minipool launch amount %d", depositData.Amount, launchAmount)
+    }
+
+    // Check withdrawal credentials & deposit data root
+    return depositData.Validate(withdrawalCredentials, depositDataRoot)
+
+}
+
+
 // Progress the prelaunch minipool to staking
+// The deposit data root is validated against the network withdrawal credentials before staking
+// The deposit signature is also verified if the minipool has a deposit fork version set
 func (mp *Minipool) Stake(validatorPubkey rptypes.ValidatorPubkey, validatorSignature rptypes.ValidatorSignature, depositDataRoot common.Hash, opts *bind.TransactOpts) (*types.Receipt, error) {
+    if err := mp.validateStake(validatorPubkey, validatorSignature, depositDataRoot, &bind.CallOpts{From: opts.From, Context: opts.Context}); err != nil {
+        return nil, fmt.Errorf("Could not stake minipool %s: %w", mp.Address.Hex(), err)
+    }
     txReceipt, err := mp.Contract.Transact(opts, "stake", validatorPubkey[:], validatorSignature[:], depositDataRoot)
     if err != nil {
         return nil, fmt.Errorf("Could not stake minipool %s: %w", mp.Address.Hex(), err)
@@ -359,6 +399,64 @@ func (mp *Minipool) Stake(validatorPubkey rptypes.ValidatorPubkey, validatorSign
 }
 
 
+// Validate stake parameters
+// The deposit data root must match deposit data built from the network withdrawal credentials and minipool launch balance
+func (mp *Minipool) validateStake(validatorPubkey rptypes.ValidatorPubkey, validatorSignature rptypes.ValidatorSignature, depositDataRoot common.Hash, opts *bind.CallOpts) error {
+    withdrawalCredentials, launchAmount, err := getDepositParameters(mp.RocketPool, opts)
+    if err != nil {
+        return err
+    }
+    depositData := rptypes.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: withdrawalCredentials,
+        Amount: launchAmount,
+        Signature: validatorSignature,
+    }
+    root, err := depositData.HashTreeRoot()
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(root.Bytes(), depositDataRoot.Bytes()) {
+        return fmt.Errorf("Deposit data root %s does not match the root %s expected for the network withdrawal credentials and launch balance", depositDataRoot.Hex(), root.Hex())
+    }
+    if mp.DepositForkVersion != nil {
+        return depositData.VerifySignature(*mp.DepositForkVersion)
+    }
+    return nil
+}
+
+
+// Get the expected withdrawal credentials and deposit amount in gwei for minipool validator deposits
+func getDepositParameters(rp *rocketpool.RocketPool, opts *bind.CallOpts) (common.Hash, uint64, error) {
+
+    // Data
+    var wg errgroup.Group
+    var withdrawalCredentials common.Hash
+    var launchBalance *big.Int
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        withdrawalCredentials, err = network.GetWithdrawalCredentials(rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        launchBalance, err = settings.GetMinipoolLaunchBalance(rp, opts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return common.Hash{}, 0, err
+    }
+
+    // Return
+    return withdrawalCredentials, new(big.Int).Div(launchBalance, eth.GweiToWei(1)).Uint64(), nil
+
+}
+
+
 // Withdraw node balances & rewards from the withdrawable minipool and close it
 func (mp *Minipool) Withdraw(opts *bind.TransactOpts) (*types.Receipt, error) {
     txReceipt, err := mp.Contract.Transact(opts, "withdraw")
@@ -379,6 +477,16 @@ func (mp *Minipool) Dissolve(opts *bind.TransactOpts) (*types.Receipt, error) {
 }
 
 
+// Estimate the gas required to dissolve the minipool
+func (mp *Minipool) EstimateDissolveGas(opts *bind.TransactOpts) (uint64, error) {
+    gas, err := mp.Contract.EstimateGas(opts, "dissolve")
+    if err != nil {
+        return 0, fmt.Errorf("Could not estimate gas to dissolve minipool %s: %w", mp.Address.Hex(), err)
+    }
+    return gas, nil
+}
+
+
 // Withdraw node balances from the dissolved minipool and close it
 func (mp *Minipool) Close(opts *bind.TransactOpts) (*types.Receipt, error) {
     txReceipt, err := mp.Contract.Transact(opts, "close")
@@ -7,6 +7,7 @@ import (
 
     "github.com/ethereum/go-ethereum/accounts/abi/bind"
     "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
     "golang.org/x/sync/errgroup"
 
     "github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -21,6 +22,14 @@ const (
 )
 
 
+// Minipool manager events
+type minipoolCreated struct {
+    Minipool common.Address
+    Node common.Address
+    Time *big.Int
+}
+
+
 // Minipool details
 type MinipoolDetails struct {
     Address common.Address              `json:"address"`
@@ -538,6 +547,23 @@ func GetMinipoolWithdrawalProcessed(rp *rocketpool.RocketPool, minipoolAddress c
 }
 
 
+// Get the address of the minipool created by a transaction
+func GetCreatedMinipoolAddress(rp *rocketpool.RocketPool, txReceipt *types.Receipt) (common.Address, error) {
+    rocketMinipoolManager, err := getRocketMinipoolManager(rp)
+    if err != nil {
+        return common.Address{}, err
+    }
+    minipoolCreatedEvents, err := rocketMinipoolManager.GetTransactionEvents(txReceipt, "MinipoolCreated", minipoolCreated{})
+    if err != nil {
+        return common.Address{}, fmt.Errorf("Could not get minipool created event: %w", err)
+    }
+    if len(minipoolCreatedEvents) == 0 {
+        return common.Address{}, fmt.Errorf("Transaction %s did not create a minipool", txReceipt.TxHash.Hex())
+    }
+    return minipoolCreatedEvents[0].(minipoolCreated).Minipool, nil
+}
+
+
 // Get contracts
 var rocketMinipoolManagerLock sync.Mutex
 func getRocketMinipoolManager(rp *rocketpool.RocketPool) (*rocketpool.Contract, error) {
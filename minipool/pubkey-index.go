@@ -0,0 +1,423 @@
+package minipool
+
+import (
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "sync"
+
+    "github.com/ethereum/go-ethereum"
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const (
+    PubkeyIndexAddressBatchSize = 100
+    PubkeyIndexPubkeyBatchSize = 20
+)
+
+
+// Pubkey index entry
+// Pubkey is unset for minipools which have not yet staked
+type PubkeyIndexEntry struct {
+    Minipool common.Address                 `json:"minipool"`
+    Node common.Address                     `json:"node"`
+    Pubkey rptypes.ValidatorPubkey          `json:"pubkey"`
+    Staked bool                             `json:"staked"`
+}
+
+
+// Pubkey resolution result
+type PubkeyResolution struct {
+    Pubkey rptypes.ValidatorPubkey          `json:"pubkey"`
+    Minipool common.Address                 `json:"minipool"`
+    Node common.Address                     `json:"node"`
+    Found bool                              `json:"found"`
+}
+
+
+// Local index of validator pubkeys to minipools and nodes
+// The index is updated incrementally from minipool created and staking events
+type PubkeyIndex struct {
+    lastBlock uint64
+    minipools map[common.Address]*PubkeyIndexEntry
+    pubkeys map[rptypes.ValidatorPubkey]common.Address
+    lock sync.RWMutex
+}
+
+
+// Serialized pubkey index
+type pubkeyIndexFile struct {
+    LastBlock uint64                        `json:"lastBlock"`
+    Entries []PubkeyIndexEntry              `json:"entries"`
+}
+
+
+// Create a new, empty pubkey index
+func NewPubkeyIndex() *PubkeyIndex {
+    return &PubkeyIndex{
+        minipools: make(map[common.Address]*PubkeyIndexEntry),
+        pubkeys: make(map[rptypes.ValidatorPubkey]common.Address),
+    }
+}
+
+
+// Load a pubkey index from disk
+// Returns an empty index if the file does not exist
+func LoadPubkeyIndex(path string) (*PubkeyIndex, error) {
+
+    // Read file
+    data, err := ioutil.ReadFile(path)
+    if errors.Is(err, os.ErrNotExist) {
+        return NewPubkeyIndex(), nil
+    }
+    if err != nil {
+        return nil, fmt.Errorf("Could not read pubkey index file %s: %w", path, err)
+    }
+
+    // Decode index
+    var indexFile pubkeyIndexFile
+    if err := json.Unmarshal(data, &indexFile); err != nil {
+        return nil, fmt.Errorf("Could not decode pubkey index file %s: %w", path, err)
+    }
+    index := NewPubkeyIndex()
+    index.lastBlock = indexFile.LastBlock
+    for _, entry := range indexFile.Entries {
+        index.setEntry(entry)
+    }
+
+    // Return
+    return index, nil
+
+}
+
+
+// Save the pubkey index to disk
+func (idx *PubkeyIndex) Save(path string) error {
+
+    // Encode index
+    idx.lock.RLock()
+    indexFile := pubkeyIndexFile{
+        LastBlock: idx.lastBlock,
+        Entries: make([]PubkeyIndexEntry, 0, len(idx.minipools)),
+    }
+    for _, entry := range idx.minipools {
+        indexFile.Entries = append(indexFile.Entries, *entry)
+    }
+    idx.lock.RUnlock()
+    data, err := json.Marshal(indexFile)
+    if err != nil {
+        return fmt.Errorf("Could not encode pubkey index: %w", err)
+    }
+
+    // Write to temporary file and replace
+    tmpPath := path + ".tmp"
+    if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+        return fmt.Errorf("Could not write pubkey index file %s: %w", tmpPath, err)
+    }
+    if err := os.Rename(tmpPath, path); err != nil {
+        return fmt.Errorf("Could not write pubkey index file %s: %w", path, err)
+    }
+    return nil
+
+}
+
+
+// Get the last block the index was updated to
+func (idx *PubkeyIndex) GetLastBlock() uint64 {
+    idx.lock.RLock()
+    defer idx.lock.RUnlock()
+    return idx.lastBlock
+}
+
+
+// Get the number of minipools in the index
+func (idx *PubkeyIndex) GetMinipoolCount() int {
+    idx.lock.RLock()
+    defer idx.lock.RUnlock()
+    return len(idx.minipools)
+}
+
+
+// Get the index entry for a minipool
+func (idx *PubkeyIndex) GetMinipool(minipoolAddress common.Address) (PubkeyIndexEntry, bool) {
+    idx.lock.RLock()
+    defer idx.lock.RUnlock()
+    entry, ok := idx.minipools[minipoolAddress]
+    if !ok { return PubkeyIndexEntry{}, false }
+    return *entry, true
+}
+
+
+// Update the index from network events up to the latest (or specified) block
+func (idx *PubkeyIndex) Update(rp *rocketpool.RocketPool, opts *bind.CallOpts) error {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return err
+    }
+    toBlock := pinnedOpts.BlockNumber.Uint64()
+
+    // Get block range
+    idx.lock.RLock()
+    fromBlock := idx.lastBlock + 1
+    if idx.lastBlock == 0 { fromBlock = rp.DeployBlock }
+    idx.lock.RUnlock()
+    if fromBlock > toBlock {
+        return nil
+    }
+
+    // Get created minipools
+    rocketMinipoolManager, err := getRocketMinipoolManager(rp)
+    if err != nil {
+        return err
+    }
+    createdEvents, err := rocketMinipoolManager.GetEvents("MinipoolCreated", minipoolCreated{}, new(big.Int).SetUint64(fromBlock), pinnedOpts.BlockNumber)
+    if err != nil {
+        return fmt.Errorf("Could not get minipool created events: %w", err)
+    }
+    created := make([]PubkeyIndexEntry, len(createdEvents))
+    for ei, event := range createdEvents {
+        created[ei] = PubkeyIndexEntry{
+            Minipool: event.Event.(minipoolCreated).Minipool,
+            Node: event.Event.(minipoolCreated).Node,
+        }
+    }
+
+    // Get unstaked minipool addresses
+    unstaked := []common.Address{}
+    idx.lock.RLock()
+    for address, entry := range idx.minipools {
+        if !entry.Staked { unstaked = append(unstaked, address) }
+    }
+    idx.lock.RUnlock()
+    for _, entry := range created {
+        if _, ok := idx.GetMinipool(entry.Minipool); !ok { unstaked = append(unstaked, entry.Minipool) }
+    }
+
+    // Get staked minipools & their pubkeys
+    stakedAddresses, err := getStakedMinipoolAddresses(rp, unstaked, fromBlock, toBlock)
+    if err != nil {
+        return err
+    }
+    stakedPubkeys := make([]rptypes.ValidatorPubkey, len(stakedAddresses))
+    for bsi := 0; bsi < len(stakedAddresses); bsi += PubkeyIndexPubkeyBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + PubkeyIndexPubkeyBatchSize
+        if mei > len(stakedAddresses) { mei = len(stakedAddresses) }
+
+        // Load pubkeys
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                pubkey, err := GetMinipoolPubkey(rp, stakedAddresses[mi], pinnedOpts)
+                if err == nil { stakedPubkeys[mi] = pubkey }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return err
+        }
+
+    }
+
+    // Update index
+    idx.lock.Lock()
+    defer idx.lock.Unlock()
+    for _, entry := range created {
+        if _, ok := idx.minipools[entry.Minipool]; !ok { idx.setEntryUnsafe(entry) }
+    }
+    for si, address := range stakedAddresses {
+        entry, ok := idx.minipools[address]
+        if !ok { continue }
+        updated := *entry
+        updated.Pubkey = stakedPubkeys[si]
+        updated.Staked = true
+        idx.setEntryUnsafe(updated)
+    }
+    idx.lastBlock = toBlock
+
+    // Return
+    return nil
+
+}
+
+
+// Resolve validator pubkeys to minipools and nodes from the index
+func (idx *PubkeyIndex) Resolve(pubkeys []rptypes.ValidatorPubkey) []PubkeyResolution {
+    idx.lock.RLock()
+    defer idx.lock.RUnlock()
+    resolutions := make([]PubkeyResolution, len(pubkeys))
+    for pi, pubkey := range pubkeys {
+        resolutions[pi] = PubkeyResolution{Pubkey: pubkey}
+        minipoolAddress, ok := idx.pubkeys[pubkey]
+        if !ok { continue }
+        entry := idx.minipools[minipoolAddress]
+        resolutions[pi].Minipool = entry.Minipool
+        resolutions[pi].Node = entry.Node
+        resolutions[pi].Found = true
+    }
+    return resolutions
+}
+
+
+// Resolve validator pubkeys to minipools and nodes from the index, falling back to network calls for pubkeys not in the index
+func (idx *PubkeyIndex) ResolveWithFallback(rp *rocketpool.RocketPool, pubkeys []rptypes.ValidatorPubkey, opts *bind.CallOpts) ([]PubkeyResolution, error) {
+
+    // Resolve from index
+    resolutions := idx.Resolve(pubkeys)
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []PubkeyResolution{}, err
+    }
+
+    // Resolve missing pubkeys in batches
+    missing := []int{}
+    for ri, resolution := range resolutions {
+        if !resolution.Found { missing = append(missing, ri) }
+    }
+    for bsi := 0; bsi < len(missing); bsi += MinipoolDetailsBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolDetailsBatchSize
+        if mei > len(missing) { mei = len(missing) }
+
+        // Resolve pubkeys
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            ri := missing[mi]
+            wg.Go(func() error {
+                resolution, err := resolvePubkey(rp, resolutions[ri].Pubkey, pinnedOpts)
+                if err == nil { resolutions[ri] = resolution }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []PubkeyResolution{}, err
+        }
+
+    }
+
+    // Return
+    return resolutions, nil
+
+}
+
+
+// Set an index entry
+func (idx *PubkeyIndex) setEntry(entry PubkeyIndexEntry) {
+    idx.lock.Lock()
+    defer idx.lock.Unlock()
+    idx.setEntryUnsafe(entry)
+}
+func (idx *PubkeyIndex) setEntryUnsafe(entry PubkeyIndexEntry) {
+    idx.minipools[entry.Minipool] = &entry
+    if entry.Staked { idx.pubkeys[entry.Pubkey] = entry.Minipool }
+}
+
+
+// Get the addresses of minipools which entered staking within a block range
+func getStakedMinipoolAddresses(rp *rocketpool.RocketPool, minipoolAddresses []common.Address, fromBlock, toBlock uint64) ([]common.Address, error) {
+
+    // Get status updated event ID
+    minipoolAbi, err := rp.GetABI("rocketMinipool")
+    if err != nil {
+        return []common.Address{}, err
+    }
+    statusUpdatedEvent, ok := minipoolAbi.Events["StatusUpdated"]
+    if !ok {
+        return []common.Address{}, errors.New("Event 'StatusUpdated' does not exist on minipool contract")
+    }
+    stakingTopic := common.BigToHash(big.NewInt(int64(rptypes.Staking)))
+
+    // Filter logs in batches of minipool addresses & pages of blocks
+    staked := []common.Address{}
+    for bsi := 0; bsi < len(minipoolAddresses); bsi += PubkeyIndexAddressBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + PubkeyIndexAddressBatchSize
+        if mei > len(minipoolAddresses) { mei = len(minipoolAddresses) }
+
+        // Filter logs
+        for psi := fromBlock; psi <= toBlock; psi += rocketpool.EventLogPageSize {
+            pei := psi + rocketpool.EventLogPageSize - 1
+            if pei > toBlock { pei = toBlock }
+            logs, err := rp.Client.FilterLogs(context.Background(), ethereum.FilterQuery{
+                FromBlock: new(big.Int).SetUint64(psi),
+                ToBlock: new(big.Int).SetUint64(pei),
+                Addresses: minipoolAddresses[msi:mei],
+                Topics: [][]common.Hash{{statusUpdatedEvent.ID}, {stakingTopic}},
+            })
+            if err != nil {
+                return []common.Address{}, fmt.Errorf("Could not get minipool staking events for blocks %d to %d: %w", psi, pei, err)
+            }
+            for _, log := range logs {
+                if !log.Removed { staked = append(staked, log.Address) }
+            }
+        }
+
+    }
+
+    // Return
+    return staked, nil
+
+}
+
+
+// Resolve a validator pubkey from the network
+func resolvePubkey(rp *rocketpool.RocketPool, pubkey rptypes.ValidatorPubkey, opts *bind.CallOpts) (PubkeyResolution, error) {
+
+    // Get minipool address
+    minipoolAddress, err := GetMinipoolByPubkey(rp, pubkey, opts)
+    if err != nil {
+        return PubkeyResolution{}, err
+    }
+    if minipoolAddress == (common.Address{}) {
+        return PubkeyResolution{Pubkey: pubkey}, nil
+    }
+
+    // Get node address if the minipool still exists
+    resolution := PubkeyResolution{
+        Pubkey: pubkey,
+        Minipool: minipoolAddress,
+        Found: true,
+    }
+    exists, err := GetMinipoolExists(rp, minipoolAddress, opts)
+    if err != nil {
+        return PubkeyResolution{}, err
+    }
+    if exists {
+        mp, err := NewMinipool(rp, minipoolAddress)
+        if err != nil {
+            return PubkeyResolution{}, err
+        }
+        resolution.Node, err = mp.GetNodeAddress(opts)
+        if err != nil {
+            return PubkeyResolution{}, err
+        }
+    }
+
+    // Return
+    return resolution, nil
+
+}
+
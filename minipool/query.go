@@ -0,0 +1,237 @@
+package minipool
+
+import (
+    "bytes"
+    "math/big"
+    "sort"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+)
+
+
+// Minipool query sort fields
+type QuerySortField uint8
+const (
+    SortByNone QuerySortField = iota
+    SortByAddress
+    SortByStatus
+    SortByStatusTime
+    SortByBalance
+    SortByNodeFee
+)
+
+
+// Minipool query
+// Filters are combined with AND; unset filters match all minipools
+type Query struct {
+    statuses []rptypes.MinipoolStatus
+    depositTypes []rptypes.MinipoolDeposit
+    nodeAddress *common.Address
+    withdrawable *bool
+    withdrawalProcessed *bool
+    statusTimeFrom time.Time
+    statusTimeTo time.Time
+    minBalance *big.Int
+    maxBalance *big.Int
+    sortField QuerySortField
+    sortDescending bool
+    offset int
+    limit int
+}
+
+
+// Create a new minipool query
+func NewQuery() *Query {
+    return &Query{}
+}
+
+
+// Filter by minipool statuses
+func (q *Query) Status(statuses ...rptypes.MinipoolStatus) *Query {
+    q.statuses = append(q.statuses, statuses...)
+    return q
+}
+
+
+// Filter by minipool deposit types
+func (q *Query) DepositType(depositTypes ...rptypes.MinipoolDeposit) *Query {
+    q.depositTypes = append(q.depositTypes, depositTypes...)
+    return q
+}
+
+
+// Filter by node address
+func (q *Query) Node(nodeAddress common.Address) *Query {
+    q.nodeAddress = &nodeAddress
+    return q
+}
+
+
+// Filter by withdrawable status
+func (q *Query) Withdrawable(withdrawable bool) *Query {
+    q.withdrawable = &withdrawable
+    return q
+}
+
+
+// Filter by withdrawal processed status
+func (q *Query) WithdrawalProcessed(processed bool) *Query {
+    q.withdrawalProcessed = &processed
+    return q
+}
+
+
+// Filter by status time range (inclusive); zero times are unbounded
+func (q *Query) StatusTimeBetween(from, to time.Time) *Query {
+    q.statusTimeFrom = from
+    q.statusTimeTo = to
+    return q
+}
+
+
+// Filter by minipool ETH balance range (inclusive); nil values are unbounded
+func (q *Query) BalanceBetween(min, max *big.Int) *Query {
+    q.minBalance = min
+    q.maxBalance = max
+    return q
+}
+
+
+// Sort results
+func (q *Query) SortBy(field QuerySortField, descending bool) *Query {
+    q.sortField = field
+    q.sortDescending = descending
+    return q
+}
+
+
+// Paginate results
+func (q *Query) Page(offset, limit int) *Query {
+    q.offset = offset
+    q.limit = limit
+    return q
+}
+
+
+// Run the query against live chain data
+// Only the queried node's minipools are loaded if a node filter is set
+func (q *Query) Run(rp *rocketpool.RocketPool, opts *bind.CallOpts) ([]MinipoolSnapshot, error) {
+    var snapshots []MinipoolSnapshot
+    var err error
+    if q.nodeAddress != nil {
+        snapshots, err = GetNodeMinipoolSnapshots(rp, *q.nodeAddress, opts)
+    } else {
+        snapshots, err = GetMinipoolSnapshots(rp, opts)
+    }
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+    return q.Filter(snapshots), nil
+}
+
+
+// Run the query against cached minipool snapshots
+func (q *Query) Filter(snapshots []MinipoolSnapshot) []MinipoolSnapshot {
+
+    // Filter snapshots
+    results := []MinipoolSnapshot{}
+    for _, snapshot := range snapshots {
+        if q.matches(snapshot) { results = append(results, snapshot) }
+    }
+
+    // Sort results
+    if q.sortField != SortByNone {
+        sort.SliceStable(results, func(i, j int) bool {
+            if q.sortDescending { return q.less(results[j], results[i]) }
+            return q.less(results[i], results[j])
+        })
+    }
+
+    // Paginate results
+    if q.offset > 0 {
+        if q.offset >= len(results) { return []MinipoolSnapshot{} }
+        results = results[q.offset:]
+    }
+    if q.limit > 0 && q.limit < len(results) {
+        results = results[:q.limit]
+    }
+
+    // Return
+    return results
+
+}
+
+
+// Check whether a minipool snapshot matches the query filters
+func (q *Query) matches(snapshot MinipoolSnapshot) bool {
+    if len(q.statuses) > 0 {
+        if !snapshot.Exists { return false }
+        matched := false
+        for _, status := range q.statuses {
+            if snapshot.Status.Status == status { matched = true }
+        }
+        if !matched { return false }
+    }
+    if len(q.depositTypes) > 0 {
+        if !snapshot.Exists { return false }
+        matched := false
+        for _, depositType := range q.depositTypes {
+            if snapshot.DepositType == depositType { matched = true }
+        }
+        if !matched { return false }
+    }
+    if q.nodeAddress != nil && !bytes.Equal(snapshot.Node.Address.Bytes(), q.nodeAddress.Bytes()) {
+        return false
+    }
+    if q.withdrawable != nil && snapshot.Withdrawable != *q.withdrawable {
+        return false
+    }
+    if q.withdrawalProcessed != nil && snapshot.WithdrawalProcessed != *q.withdrawalProcessed {
+        return false
+    }
+    if !q.statusTimeFrom.IsZero() && snapshot.Status.StatusTime.Before(q.statusTimeFrom) {
+        return false
+    }
+    if !q.statusTimeTo.IsZero() && snapshot.Status.StatusTime.After(q.statusTimeTo) {
+        return false
+    }
+    if q.minBalance != nil && (snapshot.Balance == nil || snapshot.Balance.Cmp(q.minBalance) < 0) {
+        return false
+    }
+    if q.maxBalance != nil && (snapshot.Balance == nil || snapshot.Balance.Cmp(q.maxBalance) > 0) {
+        return false
+    }
+    return true
+}
+
+
+// Compare minipool snapshots by the query sort field
+func (q *Query) less(a, b MinipoolSnapshot) bool {
+    switch q.sortField {
+        case SortByAddress:
+            return bytes.Compare(a.Address.Bytes(), b.Address.Bytes()) < 0
+        case SortByStatus:
+            return a.Status.Status < b.Status.Status
+        case SortByStatusTime:
+            return a.Status.StatusTime.Before(b.Status.StatusTime)
+        case SortByBalance:
+            return compareBalances(a.Balance, b.Balance) < 0
+        case SortByNodeFee:
+            return a.Node.Fee < b.Node.Fee
+    }
+    return false
+}
+
+
+// Compare nillable balances, treating nil as zero
+func compareBalances(a, b *big.Int) int {
+    if a == nil { a = big.NewInt(0) }
+    if b == nil { b = big.NewInt(0) }
+    return a.Cmp(b)
+}
+
@@ -0,0 +1,222 @@
+package minipool
+
+import (
+    "bytes"
+    "context"
+    "errors"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Minipool queue assignment order
+var QueueAssignmentOrder = []rptypes.MinipoolDeposit{rptypes.Half, rptypes.Full, rptypes.Empty}
+
+
+// A minipool in the queue
+type QueueItem struct {
+    Minipool common.Address                 `json:"minipool"`
+    Node common.Address                     `json:"node"`
+    DepositType rptypes.MinipoolDeposit     `json:"depositType"`
+}
+
+
+// Minipool queue simulation
+// Queued items are assigned in QueueAssignmentOrder, and in item order within each deposit type queue
+// A user deposit of DepositInflow is made every DepositInterval, after which up to MaxAssignments minipools are assigned
+type QueueSimulation struct {
+    Items []QueueItem
+    Capacities map[rptypes.MinipoolDeposit]*big.Int
+    DepositPoolBalance *big.Int
+    MaxAssignments uint64
+    DepositInflow *big.Int
+    DepositInterval time.Duration
+    StartTime time.Time
+}
+
+
+// Estimated minipool queue assignment
+// Deposits, Duration and Time are only set if the minipool is assigned during the simulation
+type QueueETA struct {
+    QueueItem
+    QueuePosition uint64                    `json:"queuePosition"`
+    AssignmentPosition uint64               `json:"assignmentPosition"`
+    Assigned bool                           `json:"assigned"`
+    Deposits uint64                         `json:"deposits"`
+    Duration time.Duration                  `json:"duration"`
+    Time time.Time                          `json:"time"`
+}
+
+
+// Create a minipool queue simulation from the current network state
+func NewQueueSimulation(rp *rocketpool.RocketPool, depositInflow *big.Int, depositInterval time.Duration, opts *bind.CallOpts) (*QueueSimulation, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return nil, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var items []QueueItem
+    var fullCapacity *big.Int
+    var halfCapacity *big.Int
+    var emptyCapacity *big.Int
+    var depositPoolBalance *big.Int
+    var maxAssignments uint64
+    var blockTime time.Time
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        items, err = GetQueueItems(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        fullCapacity, err = settings.GetMinipoolFullDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        halfCapacity, err = settings.GetMinipoolHalfDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        emptyCapacity, err = settings.GetMinipoolEmptyDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        depositPoolBalance, err = deposit.GetBalance(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        maxAssignments, err = settings.GetMaximumDepositAssignments(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        header, err := rp.Client.HeaderByNumber(context.Background(), pinnedOpts.BlockNumber)
+        if err == nil { blockTime = time.Unix(int64(header.Time), 0) }
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return nil, err
+    }
+
+    // Return
+    return &QueueSimulation{
+        Items: items,
+        Capacities: map[rptypes.MinipoolDeposit]*big.Int{
+            rptypes.Full: fullCapacity,
+            rptypes.Half: halfCapacity,
+            rptypes.Empty: emptyCapacity,
+        },
+        DepositPoolBalance: depositPoolBalance,
+        MaxAssignments: maxAssignments,
+        DepositInflow: depositInflow,
+        DepositInterval: depositInterval,
+        StartTime: blockTime,
+    }, nil
+
+}
+
+
+// Get estimated queue assignments for a node's minipools from the current network state
+func GetNodeQueueETAs(rp *rocketpool.RocketPool, nodeAddress common.Address, depositInflow *big.Int, depositInterval time.Duration, opts *bind.CallOpts) ([]QueueETA, error) {
+    simulation, err := NewQueueSimulation(rp, depositInflow, depositInterval, opts)
+    if err != nil {
+        return []QueueETA{}, err
+    }
+    etas, err := simulation.Run()
+    if err != nil {
+        return []QueueETA{}, err
+    }
+    nodeETAs := []QueueETA{}
+    for _, eta := range etas {
+        if bytes.Equal(eta.Node.Bytes(), nodeAddress.Bytes()) { nodeETAs = append(nodeETAs, eta) }
+    }
+    return nodeETAs, nil
+}
+
+
+// Run the simulation
+// Returns estimated assignments for all queued items in assignment order
+func (s *QueueSimulation) Run() ([]QueueETA, error) {
+
+    // Get queues in assignment order
+    etas := []QueueETA{}
+    for _, depositType := range QueueAssignmentOrder {
+        var queuePosition uint64
+        for _, item := range s.Items {
+            if item.DepositType != depositType { continue }
+            if capacity, ok := s.Capacities[depositType]; !ok || capacity == nil || capacity.Sign() <= 0 {
+                return []QueueETA{}, errors.New("Queue capacity not set for deposit type " + depositType.String())
+            }
+            etas = append(etas, QueueETA{
+                QueueItem: item,
+                QueuePosition: queuePosition,
+                AssignmentPosition: uint64(len(etas)),
+            })
+            queuePosition++
+        }
+    }
+
+    // Check if any assignments can be made
+    hasInflow := s.DepositInflow != nil && s.DepositInflow.Sign() > 0
+    if s.MaxAssignments == 0 || !hasInflow {
+        return etas, nil
+    }
+
+    // Simulate deposits & assignments
+    balance := new(big.Int)
+    if s.DepositPoolBalance != nil { balance.Set(s.DepositPoolBalance) }
+    var deposits uint64
+    for ei := 0; ei < len(etas); {
+
+        // Skip to the next deposit at which the next item can be assigned
+        capacity := s.Capacities[etas[ei].DepositType]
+        skip := uint64(1)
+        if balance.Cmp(capacity) < 0 {
+            shortfall := new(big.Int).Sub(capacity, balance)
+            skip = new(big.Int).Div(new(big.Int).Sub(new(big.Int).Add(shortfall, s.DepositInflow), big.NewInt(1)), s.DepositInflow).Uint64()
+            if skip == 0 { skip = 1 }
+        }
+        deposits += skip
+        balance.Add(balance, new(big.Int).Mul(s.DepositInflow, new(big.Int).SetUint64(skip)))
+
+        // Assign items
+        var assignments uint64
+        for ; ei < len(etas) && assignments < s.MaxAssignments; ei++ {
+            capacity := s.Capacities[etas[ei].DepositType]
+            if balance.Cmp(capacity) < 0 { break }
+            balance.Sub(balance, capacity)
+            etas[ei].Assigned = true
+            etas[ei].Deposits = deposits
+            etas[ei].Duration = time.Duration(deposits) * s.DepositInterval
+            if !s.StartTime.IsZero() { etas[ei].Time = s.StartTime.Add(etas[ei].Duration) }
+            assignments++
+        }
+
+    }
+
+    // Return
+    return etas, nil
+
+}
+
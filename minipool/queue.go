@@ -1,18 +1,34 @@
 package minipool
 
 import (
+    "bytes"
     "fmt"
     "math/big"
     "sync"
 
     "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
     "golang.org/x/sync/errgroup"
 
     "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/storage"
     rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
 )
 
 
+// Settings
+const QueueItemBatchSize = 50
+
+
+// Minipool queue storage keys
+var queueKeys = map[rptypes.MinipoolDeposit]common.Hash{
+    rptypes.Full: storage.NewKey().String("minipools.available.full").Hash(),
+    rptypes.Half: storage.NewKey().String("minipools.available.half").Hash(),
+    rptypes.Empty: storage.NewKey().String("minipools.available.empty").Hash(),
+}
+
+
 // Minipool queue lengths
 type QueueLengths struct {
     Total uint64
@@ -30,6 +46,24 @@ type QueueCapacity struct {
 }
 
 
+// Minipool queue position
+// QueuePosition is the minipool's position in its deposit type queue; AssignmentPosition is its position across all queues
+type QueuePosition struct {
+    Queued bool                             `json:"queued"`
+    DepositType rptypes.MinipoolDeposit     `json:"depositType"`
+    QueuePosition uint64                    `json:"queuePosition"`
+    AssignmentPosition uint64               `json:"assignmentPosition"`
+}
+
+
+// Queued minipool details
+type QueuedMinipool struct {
+    MinipoolSnapshot
+    QueuePosition uint64                    `json:"queuePosition"`
+    AssignmentPosition uint64               `json:"assignmentPosition"`
+}
+
+
 // Get minipool queue lengths
 func GetQueueLengths(rp *rocketpool.RocketPool, opts *bind.CallOpts) (QueueLengths, error) {
 
@@ -189,6 +223,268 @@ func GetQueueNextCapacity(rp *rocketpool.RocketPool, opts *bind.CallOpts) (*big.
 }
 
 
+// Get the minipool addresses in a single minipool queue, in assignment order
+func GetQueueMinipoolAddresses(rp *rocketpool.RocketPool, depositType rptypes.MinipoolDeposit, opts *bind.CallOpts) ([]common.Address, error) {
+
+    // Get queue length
+    queueLength, err := GetQueueLength(rp, depositType, opts)
+    if err != nil {
+        return []common.Address{}, err
+    }
+
+    // Load minipool addresses in batches
+    addresses := make([]common.Address, queueLength)
+    for bsi := uint64(0); bsi < queueLength; bsi += QueueItemBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + QueueItemBatchSize
+        if mei > queueLength { mei = queueLength }
+
+        // Load addresses
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                address, err := GetQueueMinipoolAt(rp, depositType, mi, opts)
+                if err == nil { addresses[mi] = address }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []common.Address{}, err
+        }
+
+    }
+
+    // Return
+    return addresses, nil
+
+}
+
+
+// Get the minipool queue items across all queues, in assignment order
+func GetQueueItems(rp *rocketpool.RocketPool, opts *bind.CallOpts) ([]QueueItem, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []QueueItem{}, err
+    }
+
+    // Get queue minipool addresses
+    var wg errgroup.Group
+    queueAddresses := make([][]common.Address, len(QueueAssignmentOrder))
+    for qi, depositType := range QueueAssignmentOrder {
+        qi, depositType := qi, depositType
+        wg.Go(func() error {
+            addresses, err := GetQueueMinipoolAddresses(rp, depositType, pinnedOpts)
+            if err == nil { queueAddresses[qi] = addresses }
+            return err
+        })
+    }
+    if err := wg.Wait(); err != nil {
+        return []QueueItem{}, err
+    }
+
+    // Get items
+    items := []QueueItem{}
+    for qi, depositType := range QueueAssignmentOrder {
+        for _, address := range queueAddresses[qi] {
+            items = append(items, QueueItem{Minipool: address, DepositType: depositType})
+        }
+    }
+
+    // Get minipool node addresses in batches
+    for bsi := 0; bsi < len(items); bsi += QueueItemBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + QueueItemBatchSize
+        if mei > len(items) { mei = len(items) }
+
+        // Load node addresses
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := NewMinipool(rp, items[mi].Minipool)
+                if err != nil {
+                    return err
+                }
+                nodeAddress, err := mp.GetNodeAddress(pinnedOpts)
+                if err == nil { items[mi].Node = nodeAddress }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []QueueItem{}, err
+        }
+
+    }
+
+    // Return
+    return items, nil
+
+}
+
+
+// Get snapshots of all queued minipools, in assignment order
+func GetQueuedMinipools(rp *rocketpool.RocketPool, opts *bind.CallOpts) ([]QueuedMinipool, error) {
+    return getQueuedMinipools(rp, nil, opts)
+}
+
+
+// Get snapshots of a node's queued minipools, in assignment order
+func GetNodeQueuedMinipools(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) ([]QueuedMinipool, error) {
+    return getQueuedMinipools(rp, &nodeAddress, opts)
+}
+
+
+// Get a minipool's position in the queue
+func GetMinipoolQueuePosition(rp *rocketpool.RocketPool, minipoolAddress common.Address, opts *bind.CallOpts) (QueuePosition, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return QueuePosition{}, err
+    }
+
+    // Get minipool deposit type
+    mp, err := NewMinipool(rp, minipoolAddress)
+    if err != nil {
+        return QueuePosition{}, err
+    }
+    depositType, err := mp.GetDepositType(pinnedOpts)
+    if err != nil {
+        return QueuePosition{}, err
+    }
+    if _, ok := queueKeys[depositType]; !ok {
+        return QueuePosition{DepositType: depositType}, nil
+    }
+
+    // Get queue index
+    index, err := GetQueueMinipoolIndex(rp, depositType, minipoolAddress, pinnedOpts)
+    if err != nil {
+        return QueuePosition{}, err
+    }
+    if index < 0 {
+        return QueuePosition{DepositType: depositType}, nil
+    }
+
+    // Get lengths of queues assigned before the minipool's queue
+    assignmentPosition := uint64(index)
+    for _, queueDepositType := range QueueAssignmentOrder {
+        if queueDepositType == depositType { break }
+        length, err := GetQueueLength(rp, queueDepositType, pinnedOpts)
+        if err != nil {
+            return QueuePosition{}, err
+        }
+        assignmentPosition += length
+    }
+
+    // Return
+    return QueuePosition{
+        Queued: true,
+        DepositType: depositType,
+        QueuePosition: uint64(index),
+        AssignmentPosition: assignmentPosition,
+    }, nil
+
+}
+
+
+// Get the minipool at an index in a single minipool queue
+func GetQueueMinipoolAt(rp *rocketpool.RocketPool, depositType rptypes.MinipoolDeposit, index uint64, opts *bind.CallOpts) (common.Address, error) {
+    queueKey, err := getQueueKey(depositType)
+    if err != nil {
+        return common.Address{}, err
+    }
+    addressQueueStorage, err := getAddressQueueStorage(rp)
+    if err != nil {
+        return common.Address{}, err
+    }
+    minipoolAddress := new(common.Address)
+    if err := addressQueueStorage.Call(opts, minipoolAddress, "getItem", queueKey, big.NewInt(int64(index))); err != nil {
+        return common.Address{}, fmt.Errorf("Could not get minipool queue item %d for deposit type %d: %w", index, depositType, err)
+    }
+    return *minipoolAddress, nil
+}
+
+
+// Get the index of a minipool in a single minipool queue; returns -1 if the minipool is not in the queue
+func GetQueueMinipoolIndex(rp *rocketpool.RocketPool, depositType rptypes.MinipoolDeposit, minipoolAddress common.Address, opts *bind.CallOpts) (int64, error) {
+    queueKey, err := getQueueKey(depositType)
+    if err != nil {
+        return 0, err
+    }
+    addressQueueStorage, err := getAddressQueueStorage(rp)
+    if err != nil {
+        return 0, err
+    }
+    index := new(*big.Int)
+    if err := addressQueueStorage.Call(opts, index, "getIndexOf", queueKey, minipoolAddress); err != nil {
+        return 0, fmt.Errorf("Could not get minipool %s queue index for deposit type %d: %w", minipoolAddress.Hex(), depositType, err)
+    }
+    return (*index).Int64(), nil
+}
+
+
+// Get snapshots of queued minipools, optionally filtered by node
+func getQueuedMinipools(rp *rocketpool.RocketPool, nodeAddress *common.Address, opts *bind.CallOpts) ([]QueuedMinipool, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []QueuedMinipool{}, err
+    }
+
+    // Get queue items
+    items, err := GetQueueItems(rp, pinnedOpts)
+    if err != nil {
+        return []QueuedMinipool{}, err
+    }
+
+    // Get queue positions & filter by node
+    queued := []QueuedMinipool{}
+    queuePositions := make(map[rptypes.MinipoolDeposit]uint64)
+    addresses := []common.Address{}
+    for ii, item := range items {
+        queuePosition := queuePositions[item.DepositType]
+        queuePositions[item.DepositType]++
+        if nodeAddress != nil && !bytes.Equal(item.Node.Bytes(), nodeAddress.Bytes()) { continue }
+        queued = append(queued, QueuedMinipool{
+            QueuePosition: queuePosition,
+            AssignmentPosition: uint64(ii),
+        })
+        addresses = append(addresses, item.Minipool)
+    }
+
+    // Get minipool snapshots
+    snapshots, err := GetMinipoolSnapshotsByAddress(rp, addresses, pinnedOpts)
+    if err != nil {
+        return []QueuedMinipool{}, err
+    }
+    for qi := range queued {
+        queued[qi].MinipoolSnapshot = snapshots[qi]
+    }
+
+    // Return
+    return queued, nil
+
+}
+
+
+// Get the storage key for a minipool queue
+func getQueueKey(depositType rptypes.MinipoolDeposit) (common.Hash, error) {
+    queueKey, ok := queueKeys[depositType]
+    if !ok {
+        return common.Hash{}, fmt.Errorf("Invalid minipool queue deposit type %d", depositType)
+    }
+    return queueKey, nil
+}
+
+
 // Get contracts
 var rocketMinipoolQueueLock sync.Mutex
 func getRocketMinipoolQueue(rp *rocketpool.RocketPool) (*rocketpool.Contract, error) {
@@ -196,4 +492,10 @@ func getRocketMinipoolQueue(rp *rocketpool.RocketPool) (*rocketpool.Contract, er
     defer rocketMinipoolQueueLock.Unlock()
     return rp.GetContract("rocketMinipoolQueue")
 }
+var addressQueueStorageLock sync.Mutex
+func getAddressQueueStorage(rp *rocketpool.RocketPool) (*rocketpool.Contract, error) {
+    addressQueueStorageLock.Lock()
+    defer addressQueueStorageLock.Unlock()
+    return rp.GetContract("addressQueueStorage")
+}
 
@@ -0,0 +1,148 @@
+package minipool
+
+import (
+    "errors"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Calculation base value
+var calcBase = eth.EthToWei(1)
+
+
+// Projected minipool node rewards
+type RewardProjection struct {
+    NodeCapital *big.Int        `json:"nodeCapital"`
+    UserCapital *big.Int        `json:"userCapital"`
+    StartBalance *big.Int       `json:"startBalance"`
+    EndBalance *big.Int         `json:"endBalance"`
+    NodeAmount *big.Int         `json:"nodeAmount"`
+    NodeRewards *big.Int        `json:"nodeRewards"`
+    NodeAPR float64             `json:"nodeApr"`
+}
+
+
+// Calculate the node reward amount for a minipool by node fee, user deposit balance, and staking start & end balances
+// Mirrors the integer arithmetic of the network's getMinipoolNodeRewardAmount, without making a contract call
+// Minipools without a start balance have no node share of rewards, so the node reward amount is 0
+func CalculateNodeRewardAmount(nodeFee float64, userDepositBalance, startBalance, endBalance *big.Int) *big.Int {
+
+    // Check start balance
+    if startBalance.Sign() <= 0 {
+        return big.NewInt(0)
+    }
+
+    // Get node balance
+    nodeBalance := big.NewInt(0)
+    if userDepositBalance.Cmp(startBalance) < 0 {
+        nodeBalance.Sub(startBalance, userDepositBalance)
+    }
+
+    // Rewards earned
+    if endBalance.Cmp(startBalance) > 0 {
+
+        // Get rewards & node / user shares
+        rewards := new(big.Int).Sub(endBalance, startBalance)
+        nodeShare := new(big.Int).Div(new(big.Int).Mul(calcBase, nodeBalance), startBalance)
+        userShare := new(big.Int).Sub(calcBase, nodeShare)
+
+        // Get node amount
+        nodeRewards := new(big.Int).Div(new(big.Int).Mul(rewards, nodeShare), calcBase)
+        nodeCommission := new(big.Int).Mul(new(big.Int).Mul(rewards, userShare), eth.EthToWei(nodeFee))
+        nodeCommission.Div(nodeCommission, new(big.Int).Mul(calcBase, calcBase))
+        return nodeBalance.Add(nodeBalance, nodeRewards).Add(nodeBalance, nodeCommission)
+
+    }
+
+    // No rewards earned; deduct losses from node balance
+    loss := new(big.Int).Sub(startBalance, endBalance)
+    if loss.Cmp(nodeBalance) < 0 {
+        return nodeBalance.Sub(nodeBalance, loss)
+    }
+    return big.NewInt(0)
+
+}
+
+
+// Project node rewards over one year for a minipool by node fee, user deposit balance, start balance and annual beacon reward rate
+// The node's capital is the start balance less the user deposit balance
+func ProjectNodeRewards(nodeFee float64, userDepositBalance, startBalance *big.Int, beaconRewardRate float64) (RewardProjection, error) {
+
+    // Get node capital
+    nodeCapital := new(big.Int).Sub(startBalance, userDepositBalance)
+    if nodeCapital.Sign() <= 0 {
+        return RewardProjection{}, errors.New("Rewards cannot be projected for a minipool without node capital")
+    }
+
+    // Get projected end balance & node amount
+    endBalance := new(big.Int).Add(startBalance, new(big.Int).Div(new(big.Int).Mul(startBalance, eth.EthToWei(beaconRewardRate)), calcBase))
+    nodeAmount := CalculateNodeRewardAmount(nodeFee, userDepositBalance, startBalance, endBalance)
+    nodeRewards := new(big.Int).Sub(nodeAmount, nodeCapital)
+
+    // Return
+    return RewardProjection{
+        NodeCapital: nodeCapital,
+        UserCapital: new(big.Int).Set(userDepositBalance),
+        StartBalance: new(big.Int).Set(startBalance),
+        EndBalance: endBalance,
+        NodeAmount: nodeAmount,
+        NodeRewards: nodeRewards,
+        NodeAPR: eth.WeiToEth(nodeRewards) / eth.WeiToEth(nodeCapital),
+    }, nil
+
+}
+
+
+// Project node rewards over one year for a minipool deposit type by node fee and annual beacon reward rate
+// Deposit amounts are loaded from the network settings
+func GetNodeRewardProjection(rp *rocketpool.RocketPool, depositType rptypes.MinipoolDeposit, nodeFee float64, beaconRewardRate float64, opts *bind.CallOpts) (RewardProjection, error) {
+
+    // Get user deposit amount getter
+    var getUserDepositAmount func(*rocketpool.RocketPool, *bind.CallOpts) (*big.Int, error)
+    switch depositType {
+        case rptypes.Full:
+            getUserDepositAmount = settings.GetMinipoolFullDepositUserAmount
+        case rptypes.Half:
+            getUserDepositAmount = settings.GetMinipoolHalfDepositUserAmount
+        case rptypes.Empty:
+            return RewardProjection{}, errors.New("Rewards cannot be projected for empty deposit minipools, which have no node capital")
+        default:
+            return RewardProjection{}, fmt.Errorf("Invalid minipool deposit type '%d'", depositType)
+    }
+
+    // Data
+    var wg errgroup.Group
+    var launchBalance *big.Int
+    var userDepositAmount *big.Int
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        launchBalance, err = settings.GetMinipoolLaunchBalance(rp, opts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        userDepositAmount, err = getUserDepositAmount(rp, opts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return RewardProjection{}, err
+    }
+
+    // Return
+    return ProjectNodeRewards(nodeFee, userDepositAmount, launchBalance, beaconRewardRate)
+
+}
+
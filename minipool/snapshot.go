@@ -0,0 +1,170 @@
+package minipool
+
+import (
+    "context"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Full minipool state at a single block
+// Contract details are only loaded for minipools which still exist
+type MinipoolSnapshot struct {
+    MinipoolDetails
+    Block uint64                        `json:"block"`
+    Status StatusDetails                `json:"status"`
+    DepositType rptypes.MinipoolDeposit `json:"depositType"`
+    Node NodeDetails                    `json:"node"`
+    User UserDetails                    `json:"user"`
+    Staking StakingDetails              `json:"staking"`
+    Balance *big.Int                    `json:"balance"`
+}
+
+
+// Get all minipool snapshots
+func GetMinipoolSnapshots(rp *rocketpool.RocketPool, opts *bind.CallOpts) ([]MinipoolSnapshot, error) {
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+    minipoolAddresses, err := GetMinipoolAddresses(rp, pinnedOpts)
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+    return GetMinipoolSnapshotsByAddress(rp, minipoolAddresses, pinnedOpts)
+}
+
+
+// Get a node's minipool snapshots
+func GetNodeMinipoolSnapshots(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) ([]MinipoolSnapshot, error) {
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+    minipoolAddresses, err := GetNodeMinipoolAddresses(rp, nodeAddress, pinnedOpts)
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+    return GetMinipoolSnapshotsByAddress(rp, minipoolAddresses, pinnedOpts)
+}
+
+
+// Get minipool snapshots by address
+func GetMinipoolSnapshotsByAddress(rp *rocketpool.RocketPool, minipoolAddresses []common.Address, opts *bind.CallOpts) ([]MinipoolSnapshot, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []MinipoolSnapshot{}, err
+    }
+
+    // Load minipool snapshots in batches
+    snapshots := make([]MinipoolSnapshot, len(minipoolAddresses))
+    for bsi := 0; bsi < len(minipoolAddresses); bsi += MinipoolDetailsBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + MinipoolDetailsBatchSize
+        if mei > len(minipoolAddresses) { mei = len(minipoolAddresses) }
+
+        // Load snapshots
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                minipoolAddress := minipoolAddresses[mi]
+                snapshot, err := GetMinipoolSnapshot(rp, minipoolAddress, pinnedOpts)
+                if err == nil { snapshots[mi] = snapshot }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []MinipoolSnapshot{}, err
+        }
+
+    }
+
+    // Return
+    return snapshots, nil
+
+}
+
+
+// Get a minipool's snapshot
+func GetMinipoolSnapshot(rp *rocketpool.RocketPool, minipoolAddress common.Address, opts *bind.CallOpts) (MinipoolSnapshot, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return MinipoolSnapshot{}, err
+    }
+
+    // Get minipool manager details
+    details, err := GetMinipoolDetails(rp, minipoolAddress, pinnedOpts)
+    if err != nil {
+        return MinipoolSnapshot{}, err
+    }
+    snapshot := MinipoolSnapshot{
+        MinipoolDetails: details,
+        Block: pinnedOpts.BlockNumber.Uint64(),
+    }
+    if !details.Exists {
+        return snapshot, nil
+    }
+
+    // Create minipool
+    mp, err := NewMinipool(rp, minipoolAddress)
+    if err != nil {
+        return MinipoolSnapshot{}, err
+    }
+
+    // Load data
+    var wg errgroup.Group
+    wg.Go(func() error {
+        var err error
+        snapshot.Status, err = mp.GetStatusDetails(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        snapshot.DepositType, err = mp.GetDepositType(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        snapshot.Node, err = mp.GetNodeDetails(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        snapshot.User, err = mp.GetUserDetails(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        snapshot.Staking, err = mp.GetStakingDetails(pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        snapshot.Balance, err = rp.Client.BalanceAt(context.Background(), minipoolAddress, pinnedOpts.BlockNumber)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return MinipoolSnapshot{}, err
+    }
+
+    // Return
+    return snapshot, nil
+
+}
+
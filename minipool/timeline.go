@@ -0,0 +1,176 @@
+package minipool
+
+import (
+    "context"
+    "fmt"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Minipool events
+type statusUpdated struct {
+    Status uint8
+    Time *big.Int
+}
+
+
+// Minipool status transition
+// Duration is the time spent in the status, up to the timeline block for the current status
+type StatusTransition struct {
+    Status rptypes.MinipoolStatus   `json:"status"`
+    Block uint64                    `json:"block"`
+    Time time.Time                  `json:"time"`
+    TxHash common.Hash              `json:"txHash"`
+    Actor common.Address            `json:"actor"`
+    Duration time.Duration          `json:"duration"`
+}
+
+
+// Minipool status timeline
+type StatusTimeline struct {
+    Minipool common.Address         `json:"minipool"`
+    Block uint64                    `json:"block"`
+    BlockTime time.Time             `json:"blockTime"`
+    Transitions []StatusTransition  `json:"transitions"`
+}
+
+
+// Get a status transition from the timeline
+func (t StatusTimeline) GetTransition(status rptypes.MinipoolStatus) (StatusTransition, bool) {
+    for _, transition := range t.Transitions {
+        if transition.Status == status { return transition, true }
+    }
+    return StatusTransition{}, false
+}
+
+
+// Get the duration between transitions to two statuses
+func (t StatusTimeline) GetDurationBetween(from, to rptypes.MinipoolStatus) (time.Duration, bool) {
+    fromTransition, ok := t.GetTransition(from)
+    if !ok { return 0, false }
+    toTransition, ok := t.GetTransition(to)
+    if !ok { return 0, false }
+    return toTransition.Time.Sub(fromTransition.Time), true
+}
+
+
+// Get the minipool's status timeline from network events
+// Transition actors are the senders of the transactions which triggered them
+func (mp *Minipool) GetStatusTimeline(opts *bind.CallOpts) (StatusTimeline, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(mp.RocketPool.Client, opts)
+    if err != nil {
+        return StatusTimeline{}, err
+    }
+
+    // Get minipool created events
+    rocketMinipoolManager, err := getRocketMinipoolManager(mp.RocketPool)
+    if err != nil {
+        return StatusTimeline{}, err
+    }
+    createdEvents, err := rocketMinipoolManager.GetEvents("MinipoolCreated", minipoolCreated{}, new(big.Int).SetUint64(mp.RocketPool.DeployBlock), pinnedOpts.BlockNumber, []common.Hash{common.BytesToHash(mp.Address.Bytes())})
+    if err != nil {
+        return StatusTimeline{}, fmt.Errorf("Could not get minipool %s created event: %w", mp.Address.Hex(), err)
+    }
+
+    // Get status updated events
+    fromBlock := new(big.Int).SetUint64(mp.RocketPool.DeployBlock)
+    if len(createdEvents) > 0 {
+        fromBlock.SetUint64(createdEvents[0].Log.BlockNumber)
+    }
+    statusEvents, err := mp.Contract.GetEvents("StatusUpdated", statusUpdated{}, fromBlock, pinnedOpts.BlockNumber)
+    if err != nil {
+        return StatusTimeline{}, fmt.Errorf("Could not get minipool %s status updated events: %w", mp.Address.Hex(), err)
+    }
+
+    // Get transition logs
+    transitionLogs := []types.Log{}
+    statuses := []rptypes.MinipoolStatus{}
+    hasInitialized := false
+    for _, event := range statusEvents {
+        if rptypes.MinipoolStatus(event.Event.(statusUpdated).Status) == rptypes.Initialized { hasInitialized = true }
+    }
+    if !hasInitialized && len(createdEvents) > 0 {
+        transitionLogs = append(transitionLogs, createdEvents[0].Log)
+        statuses = append(statuses, rptypes.Initialized)
+    }
+    for _, event := range statusEvents {
+        transitionLogs = append(transitionLogs, event.Log)
+        statuses = append(statuses, rptypes.MinipoolStatus(event.Event.(statusUpdated).Status))
+    }
+
+    // Data
+    var wg errgroup.Group
+    transitions := make([]StatusTransition, len(transitionLogs))
+    var blockTime time.Time
+
+    // Load data
+    for ti, log := range transitionLogs {
+        ti, log := ti, log
+        transitions[ti] = StatusTransition{
+            Status: statuses[ti],
+            Block: log.BlockNumber,
+            TxHash: log.TxHash,
+        }
+        wg.Go(func() error {
+            header, err := mp.RocketPool.Client.HeaderByHash(context.Background(), log.BlockHash)
+            if err == nil { transitions[ti].Time = time.Unix(int64(header.Time), 0) }
+            return err
+        })
+        wg.Go(func() error {
+            actor, err := getTransactionSender(mp.RocketPool, log)
+            if err == nil { transitions[ti].Actor = actor }
+            return err
+        })
+    }
+    wg.Go(func() error {
+        header, err := mp.RocketPool.Client.HeaderByNumber(context.Background(), pinnedOpts.BlockNumber)
+        if err == nil { blockTime = time.Unix(int64(header.Time), 0) }
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return StatusTimeline{}, fmt.Errorf("Could not get minipool %s status timeline: %w", mp.Address.Hex(), err)
+    }
+
+    // Get status durations
+    for ti := range transitions {
+        if ti < len(transitions) - 1 {
+            transitions[ti].Duration = transitions[ti + 1].Time.Sub(transitions[ti].Time)
+        } else {
+            transitions[ti].Duration = blockTime.Sub(transitions[ti].Time)
+        }
+    }
+
+    // Return
+    return StatusTimeline{
+        Minipool: mp.Address,
+        Block: pinnedOpts.BlockNumber.Uint64(),
+        BlockTime: blockTime,
+        Transitions: transitions,
+    }, nil
+
+}
+
+
+// Get the sender of the transaction which emitted a log
+func getTransactionSender(rp *rocketpool.RocketPool, log types.Log) (common.Address, error) {
+    tx, _, err := rp.Client.TransactionByHash(context.Background(), log.TxHash)
+    if err != nil {
+        return common.Address{}, err
+    }
+    return rp.Client.TransactionSender(context.Background(), tx, log.BlockHash, log.TxIndex)
+}
+
@@ -84,6 +84,20 @@ func ProcessWithdrawal(rp *rocketpool.RocketPool, validatorPubkey rptypes.Valida
 }
 
 
+// Estimate the gas required to process a validator withdrawal
+func EstimateProcessWithdrawalGas(rp *rocketpool.RocketPool, validatorPubkey rptypes.ValidatorPubkey, opts *bind.TransactOpts) (uint64, error) {
+    rocketNetworkWithdrawal, err := getRocketNetworkWithdrawal(rp)
+    if err != nil {
+        return 0, err
+    }
+    gas, err := rocketNetworkWithdrawal.EstimateGas(opts, "processWithdrawal", validatorPubkey[:])
+    if err != nil {
+        return 0, fmt.Errorf("Could not estimate gas to process validator %s withdrawal: %w", validatorPubkey.Hex(), err)
+    }
+    return gas, nil
+}
+
+
 // Get contracts
 var rocketNetworkWithdrawalLock sync.Mutex
 func getRocketNetworkWithdrawal(rp *rocketpool.RocketPool) (*rocketpool.Contract, error) {
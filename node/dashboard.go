@@ -0,0 +1,131 @@
+package node
+
+import (
+    "context"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/tokens"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Node operator dashboard
+// Staked balances are keyed by minipool status and include node & user deposit balances
+// The withdrawable balance is the node's share of minipools marked withdrawable by the network
+type NodeDashboard struct {
+    NodeDetails
+    Block uint64                                `json:"block"`
+    BlockTime time.Time                         `json:"blockTime"`
+    Balances tokens.Balances                    `json:"balances"`
+    NetworkNodeFee float64                      `json:"networkNodeFee"`
+    Minipools []minipool.MinipoolSnapshot       `json:"minipools"`
+    MinipoolCounts map[string]uint64            `json:"minipoolCounts"`
+    StakedByStatus map[string]*big.Int          `json:"stakedByStatus"`
+    NodeStakedByStatus map[string]*big.Int      `json:"nodeStakedByStatus"`
+    RefundBalance *big.Int                      `json:"refundBalance"`
+    WithdrawableBalance *big.Int                `json:"withdrawableBalance"`
+}
+
+
+// Get a node operator dashboard
+// All data is loaded at a single block
+func GetNodeDashboard(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) (NodeDashboard, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return NodeDashboard{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var details NodeDetails
+    var balances tokens.Balances
+    var networkNodeFee float64
+    var minipools []minipool.MinipoolSnapshot
+    var blockTime time.Time
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        details, err = GetNodeDetails(rp, nodeAddress, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        balances, err = tokens.GetBalances(rp, nodeAddress, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        networkNodeFee, err = network.GetNodeFee(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        minipools, err = minipool.GetNodeMinipoolSnapshots(rp, nodeAddress, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        header, err := rp.Client.HeaderByNumber(context.Background(), pinnedOpts.BlockNumber)
+        if err == nil { blockTime = time.Unix(int64(header.Time), 0) }
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return NodeDashboard{}, err
+    }
+
+    // Initialize dashboard
+    dashboard := NodeDashboard{
+        NodeDetails: details,
+        Block: pinnedOpts.BlockNumber.Uint64(),
+        BlockTime: blockTime,
+        Balances: balances,
+        NetworkNodeFee: networkNodeFee,
+        Minipools: minipools,
+        MinipoolCounts: make(map[string]uint64),
+        StakedByStatus: make(map[string]*big.Int),
+        NodeStakedByStatus: make(map[string]*big.Int),
+        RefundBalance: big.NewInt(0),
+        WithdrawableBalance: big.NewInt(0),
+    }
+    for _, statusName := range rptypes.MinipoolStatuses {
+        dashboard.MinipoolCounts[statusName] = 0
+        dashboard.StakedByStatus[statusName] = big.NewInt(0)
+        dashboard.NodeStakedByStatus[statusName] = big.NewInt(0)
+    }
+
+    // Get minipool totals
+    for _, mp := range minipools {
+        if !mp.Exists { continue }
+        statusName := mp.Status.Status.String()
+        dashboard.MinipoolCounts[statusName]++
+        addBalance(dashboard.StakedByStatus[statusName], mp.Node.DepositBalance)
+        addBalance(dashboard.StakedByStatus[statusName], mp.User.DepositBalance)
+        addBalance(dashboard.NodeStakedByStatus[statusName], mp.Node.DepositBalance)
+        addBalance(dashboard.RefundBalance, mp.Node.RefundBalance)
+        if mp.Withdrawable { addBalance(dashboard.WithdrawableBalance, mp.WithdrawalNodeBalance) }
+    }
+
+    // Return
+    return dashboard, nil
+
+}
+
+
+// Add a possibly nil balance to a total
+func addBalance(total, balance *big.Int) {
+    if balance != nil { total.Add(total, balance) }
+}
+
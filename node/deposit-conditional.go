@@ -0,0 +1,90 @@
+package node
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+
+// Settings
+const DefaultNodeFeePollInterval = 5 * time.Second
+
+
+// Errors
+var ErrNodeFeeDeadlineExceeded = errors.New("The node fee did not reach the target before the deadline")
+
+
+// Conditional node deposit settings
+// A zero deadline waits until the context is cancelled
+type ConditionalDepositConfig struct {
+    TargetNodeFee float64                       // The deposit is made once the node fee is at least this value
+    Deadline time.Time                          // Time after which to stop waiting for the target node fee
+    PollInterval time.Duration                  // Time between checks for a new block
+    OnNodeFee func(block uint64, fee float64)   // Called with the node fee at each new block
+    OnError func(error)                         // Called with node fee check errors, which are retried at the next check
+}
+
+
+// Make a node deposit once the network node fee reaches a target
+// The node fee is checked at each new block, and again immediately before the deposit transaction is sent
+// The target node fee is passed as the deposit's minimum node fee, so the deposit reverts if the fee drops before it is mined
+// Node fee check errors do not stop the wait; cancellation only applies while waiting, and once sent, the deposit transaction is always waited on
+func DepositAtNodeFee(ctx context.Context, rp *rocketpool.RocketPool, config ConditionalDepositConfig, opts *bind.TransactOpts) (DepositResult, error) {
+
+    // Apply deadline
+    if !config.Deadline.IsZero() {
+        var cancel context.CancelFunc
+        ctx, cancel = context.WithDeadline(ctx, config.Deadline)
+        defer cancel()
+    }
+
+    // Get poll interval
+    pollInterval := config.PollInterval
+    if pollInterval <= 0 { pollInterval = DefaultNodeFeePollInterval }
+    ticker := time.NewTicker(pollInterval)
+    defer ticker.Stop()
+
+    // Wait for target node fee
+    var lastBlock uint64
+    for {
+
+        // Check node fee at new blocks, and re-check against pending state before sending
+        // Blocks are only marked as checked once their node fee is loaded, so failed checks are retried
+        header, err := rp.Client.HeaderByNumber(ctx, nil)
+        if err == nil && header.Number.Uint64() != lastBlock {
+            var nodeFee float64
+            nodeFee, err = network.GetNodeFee(rp, &bind.CallOpts{BlockNumber: header.Number, Context: ctx})
+            if err == nil {
+                lastBlock = header.Number.Uint64()
+                if config.OnNodeFee != nil { config.OnNodeFee(lastBlock, nodeFee) }
+            }
+            if err == nil && nodeFee >= config.TargetNodeFee {
+                nodeFee, err = network.GetNodeFee(rp, &bind.CallOpts{Pending: true, Context: ctx})
+                if err == nil && nodeFee >= config.TargetNodeFee && ctx.Err() == nil {
+                    return DepositWithResult(rp, config.TargetNodeFee, opts)
+                }
+            }
+        }
+        if err != nil && ctx.Err() == nil && config.OnError != nil {
+            config.OnError(fmt.Errorf("Could not check node fee: %w", err))
+        }
+
+        // Wait for next check
+        select {
+            case <-ctx.Done():
+                if errors.Is(ctx.Err(), context.DeadlineExceeded) { return DepositResult{}, ErrNodeFeeDeadlineExceeded }
+                return DepositResult{}, ctx.Err()
+            case <-ticker.C:
+        }
+
+    }
+
+}
+
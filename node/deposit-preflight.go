@@ -0,0 +1,305 @@
+package node
+
+import (
+    "context"
+    "encoding/json"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Reasons a node deposit would fail
+type DepositBlockReason uint8
+const (
+    DepositNodeNotRegistered DepositBlockReason = iota
+    DepositNodeDepositsDisabled
+    DepositInvalidAmount
+    DepositEmptyNotTrusted
+    DepositNodeFeeTooLow
+    DepositInsufficientBalance
+)
+var DepositBlockReasons = []string{"NodeNotRegistered", "NodeDepositsDisabled", "InvalidAmount", "EmptyNotTrusted", "NodeFeeTooLow", "InsufficientBalance"}
+
+
+// Warnings for a node deposit which would succeed
+type DepositWarning uint8
+const (
+    DepositNoMinimumNodeFee DepositWarning = iota
+    DepositAssignmentsDisabled
+    DepositNotAssignedImmediately
+)
+var DepositWarnings = []string{"NoMinimumNodeFee", "AssignmentsDisabled", "NotAssignedImmediately"}
+
+
+// String conversion
+func (r DepositBlockReason) String() string {
+    if int(r) >= len(DepositBlockReasons) { return "" }
+    return DepositBlockReasons[r]
+}
+func (w DepositWarning) String() string {
+    if int(w) >= len(DepositWarnings) { return "" }
+    return DepositWarnings[w]
+}
+
+
+// JSON encoding
+func (r DepositBlockReason) MarshalJSON() ([]byte, error) {
+    str := r.String()
+    if str == "" {
+        return []byte{}, fmt.Errorf("Invalid deposit block reason '%d'", r)
+    }
+    return json.Marshal(str)
+}
+func (w DepositWarning) MarshalJSON() ([]byte, error) {
+    str := w.String()
+    if str == "" {
+        return []byte{}, fmt.Errorf("Invalid deposit warning '%d'", w)
+    }
+    return json.Marshal(str)
+}
+
+
+// Node & network state used to check a node deposit
+// Queue lengths and user amounts are keyed by deposit type
+type DepositPreflightState struct {
+    NodeExists bool
+    NodeTrusted bool
+    NodeDepositEnabled bool
+    AssignDepositsEnabled bool
+    DepositAmount *big.Int
+    AccountBalance *big.Int
+    MinimumNodeFee float64
+    NodeFee float64
+    NodeAmounts map[rptypes.MinipoolDeposit]*big.Int
+    UserAmounts map[rptypes.MinipoolDeposit]*big.Int
+    QueueLengths map[rptypes.MinipoolDeposit]uint64
+    DepositPoolBalance *big.Int
+    MaxDepositAssignments uint64
+}
+
+
+// Node deposit preflight check result
+// DepositType is None if the deposit amount does not match any deposit type
+type DepositPreflight struct {
+    CanDeposit bool                         `json:"canDeposit"`
+    DepositType rptypes.MinipoolDeposit     `json:"depositType"`
+    NodeFee float64                         `json:"nodeFee"`
+    Reasons []DepositBlockReason            `json:"reasons"`
+    Warnings []DepositWarning               `json:"warnings"`
+}
+
+
+// Check whether a node deposit would succeed
+// The sending account's balance is checked against the deposit amount only, excluding gas
+func CheckDeposit(rp *rocketpool.RocketPool, nodeAddress common.Address, depositAmount *big.Int, minimumNodeFee float64, opts *bind.CallOpts) (DepositPreflight, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return DepositPreflight{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    state := DepositPreflightState{
+        DepositAmount: depositAmount,
+        MinimumNodeFee: minimumNodeFee,
+        NodeAmounts: make(map[rptypes.MinipoolDeposit]*big.Int),
+        UserAmounts: make(map[rptypes.MinipoolDeposit]*big.Int),
+    }
+    var fullNodeAmount, halfNodeAmount, emptyNodeAmount *big.Int
+    var fullUserAmount, halfUserAmount, emptyUserAmount *big.Int
+    var queueLengths minipool.QueueLengths
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        state.NodeExists, err = GetNodeExists(rp, nodeAddress, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.NodeTrusted, err = GetNodeTrusted(rp, nodeAddress, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.NodeDepositEnabled, err = settings.GetNodeDepositEnabled(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.AssignDepositsEnabled, err = settings.GetAssignDepositsEnabled(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.AccountBalance, err = rp.Client.BalanceAt(context.Background(), nodeAddress, pinnedOpts.BlockNumber)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.NodeFee, err = network.GetNodeFee(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        fullNodeAmount, err = settings.GetMinipoolFullDepositNodeAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        halfNodeAmount, err = settings.GetMinipoolHalfDepositNodeAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        emptyNodeAmount, err = settings.GetMinipoolEmptyDepositNodeAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        fullUserAmount, err = settings.GetMinipoolFullDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        halfUserAmount, err = settings.GetMinipoolHalfDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        emptyUserAmount, err = settings.GetMinipoolEmptyDepositUserAmount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        queueLengths, err = minipool.GetQueueLengths(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.DepositPoolBalance, err = deposit.GetBalance(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        state.MaxDepositAssignments, err = settings.GetMaximumDepositAssignments(rp, pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return DepositPreflight{}, err
+    }
+
+    // Set deposit type values
+    state.NodeAmounts[rptypes.Full] = fullNodeAmount
+    state.NodeAmounts[rptypes.Half] = halfNodeAmount
+    state.NodeAmounts[rptypes.Empty] = emptyNodeAmount
+    state.UserAmounts[rptypes.Full] = fullUserAmount
+    state.UserAmounts[rptypes.Half] = halfUserAmount
+    state.UserAmounts[rptypes.Empty] = emptyUserAmount
+    state.QueueLengths = map[rptypes.MinipoolDeposit]uint64{
+        rptypes.Full: queueLengths.FullDeposit,
+        rptypes.Half: queueLengths.HalfDeposit,
+        rptypes.Empty: queueLengths.EmptyDeposit,
+    }
+
+    // Return
+    return GetDepositPreflight(state), nil
+
+}
+
+
+// Get the node deposit preflight check result for a node & network state
+func GetDepositPreflight(state DepositPreflightState) DepositPreflight {
+    preflight := DepositPreflight{
+        DepositType: GetDepositType(state.DepositAmount, state.NodeAmounts),
+        NodeFee: state.NodeFee,
+        Reasons: []DepositBlockReason{},
+        Warnings: []DepositWarning{},
+    }
+
+    // Check blocking conditions
+    if !state.NodeExists {
+        preflight.Reasons = append(preflight.Reasons, DepositNodeNotRegistered)
+    }
+    if !state.NodeDepositEnabled {
+        preflight.Reasons = append(preflight.Reasons, DepositNodeDepositsDisabled)
+    }
+    if preflight.DepositType == rptypes.None {
+        preflight.Reasons = append(preflight.Reasons, DepositInvalidAmount)
+    }
+    if preflight.DepositType == rptypes.Empty && !state.NodeTrusted {
+        preflight.Reasons = append(preflight.Reasons, DepositEmptyNotTrusted)
+    }
+    if state.NodeFee < state.MinimumNodeFee {
+        preflight.Reasons = append(preflight.Reasons, DepositNodeFeeTooLow)
+    }
+    if state.AccountBalance != nil && state.DepositAmount != nil && state.AccountBalance.Cmp(state.DepositAmount) < 0 {
+        preflight.Reasons = append(preflight.Reasons, DepositInsufficientBalance)
+    }
+    preflight.CanDeposit = (len(preflight.Reasons) == 0)
+
+    // Check warning conditions
+    if state.MinimumNodeFee <= 0 {
+        preflight.Warnings = append(preflight.Warnings, DepositNoMinimumNodeFee)
+    }
+    if preflight.DepositType != rptypes.None {
+        if !state.AssignDepositsEnabled {
+            preflight.Warnings = append(preflight.Warnings, DepositAssignmentsDisabled)
+        } else if !isAssignedImmediately(state, preflight.DepositType) {
+            preflight.Warnings = append(preflight.Warnings, DepositNotAssignedImmediately)
+        }
+    }
+
+    // Return
+    return preflight
+
+}
+
+
+// Get the minipool deposit type for a node deposit amount
+// Returns None if the amount does not match any deposit type
+func GetDepositType(depositAmount *big.Int, nodeAmounts map[rptypes.MinipoolDeposit]*big.Int) rptypes.MinipoolDeposit {
+    if depositAmount == nil { return rptypes.None }
+    for _, depositType := range []rptypes.MinipoolDeposit{rptypes.Full, rptypes.Half, rptypes.Empty} {
+        if nodeAmount, ok := nodeAmounts[depositType]; ok && nodeAmount != nil && nodeAmount.Cmp(depositAmount) == 0 { return depositType }
+    }
+    return rptypes.None
+}
+
+
+// Check whether a new minipool would be assigned user ETH by its node deposit
+// The minipool is appended to its deposit type queue, behind all minipools ahead of it in assignment order
+func isAssignedImmediately(state DepositPreflightState, depositType rptypes.MinipoolDeposit) bool {
+    if state.DepositPoolBalance == nil { return false }
+    var itemsAhead uint64
+    capacityAhead := big.NewInt(0)
+    for _, queueType := range minipool.QueueAssignmentOrder {
+        userAmount := state.UserAmounts[queueType]
+        if userAmount == nil { return false }
+        if queueType == depositType {
+            itemsAhead += state.QueueLengths[queueType]
+            capacityAhead.Add(capacityAhead, new(big.Int).Mul(userAmount, new(big.Int).SetUint64(state.QueueLengths[queueType] + 1)))
+            break
+        }
+        itemsAhead += state.QueueLengths[queueType]
+        capacityAhead.Add(capacityAhead, new(big.Int).Mul(userAmount, new(big.Int).SetUint64(state.QueueLengths[queueType])))
+    }
+    return itemsAhead < state.MaxDepositAssignments && state.DepositPoolBalance.Cmp(capacityAhead) >= 0
+}
+
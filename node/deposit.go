@@ -2,16 +2,35 @@ package node
 
 import (
     "fmt"
+    "math/big"
     "sync"
 
     "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
     "github.com/ethereum/go-ethereum/core/types"
+    "golang.org/x/sync/errgroup"
 
+    "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
     "github.com/rocket-pool/rocketpool-go/utils/eth"
 )
 
 
+// Node deposit result
+// UserDepositAssigned is set if user ETH was assigned to the minipool by the deposit transaction
+type DepositResult struct {
+    TxReceipt *types.Receipt                `json:"-"`
+    MinipoolAddress common.Address          `json:"minipoolAddress"`
+    Minipool *minipool.Minipool             `json:"-"`
+    DepositType rptypes.MinipoolDeposit     `json:"depositType"`
+    NodeFee float64                         `json:"nodeFee"`
+    Status rptypes.MinipoolStatus           `json:"status"`
+    UserDepositAssigned bool                `json:"userDepositAssigned"`
+    UserDepositBalance *big.Int             `json:"userDepositBalance"`
+}
+
+
 // Make a node deposit
 func Deposit(rp *rocketpool.RocketPool, minimumNodeFee float64, opts *bind.TransactOpts) (*types.Receipt, error) {
     rocketNodeDeposit, err := getRocketNodeDeposit(rp)
@@ -26,6 +45,75 @@ func Deposit(rp *rocketpool.RocketPool, minimumNodeFee float64, opts *bind.Trans
 }
 
 
+// Make a node deposit and get the created minipool
+// The result's transaction receipt is set if the deposit was mined, even if the created minipool could not be loaded
+func DepositWithResult(rp *rocketpool.RocketPool, minimumNodeFee float64, opts *bind.TransactOpts) (DepositResult, error) {
+    txReceipt, err := Deposit(rp, minimumNodeFee, opts)
+    if err != nil {
+        return DepositResult{}, err
+    }
+    return GetDepositResult(rp, txReceipt)
+}
+
+
+// Get the result of a mined node deposit transaction, with the created minipool's details as of the deposit block
+// The result's transaction receipt is set even if the created minipool could not be loaded
+func GetDepositResult(rp *rocketpool.RocketPool, txReceipt *types.Receipt) (DepositResult, error) {
+
+    // Get created minipool
+    var err error
+    result := DepositResult{TxReceipt: txReceipt}
+    result.MinipoolAddress, err = minipool.GetCreatedMinipoolAddress(rp, txReceipt)
+    if err != nil {
+        return result, err
+    }
+    result.Minipool, err = minipool.NewMinipool(rp, result.MinipoolAddress)
+    if err != nil {
+        return result, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    callOpts := &bind.CallOpts{BlockNumber: txReceipt.BlockNumber}
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        result.DepositType, err = result.Minipool.GetDepositType(callOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        result.NodeFee, err = result.Minipool.GetNodeFee(callOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        result.Status, err = result.Minipool.GetStatus(callOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        result.UserDepositAssigned, err = result.Minipool.GetUserDepositAssigned(callOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        result.UserDepositBalance, err = result.Minipool.GetUserDepositBalance(callOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return result, err
+    }
+
+    // Return
+    return result, nil
+
+}
+
+
 // Get contracts
 var rocketNodeDepositLock sync.Mutex
 func getRocketNodeDeposit(rp *rocketpool.RocketPool) (*rocketpool.Contract, error) {
@@ -334,6 +334,9 @@ func GetNodeTimezoneLocation(rp *rocketpool.RocketPool, nodeAddress common.Addre
 
 // Register a node
 func RegisterNode(rp *rocketpool.RocketPool, timezoneLocation string, opts *bind.TransactOpts) (*types.Receipt, error) {
+    if err := ValidateTimezoneLocation(timezoneLocation); err != nil {
+        return nil, err
+    }
     rocketNodeManager, err := getRocketNodeManager(rp)
     if err != nil {
         return nil, err
@@ -362,6 +365,9 @@ func SetNodeTrusted(rp *rocketpool.RocketPool, nodeAddress common.Address, trust
 
 // Set a node's timezone location
 func SetTimezoneLocation(rp *rocketpool.RocketPool, timezoneLocation string, opts *bind.TransactOpts) (*types.Receipt, error) {
+    if err := ValidateTimezoneLocation(timezoneLocation); err != nil {
+        return nil, err
+    }
     rocketNodeManager, err := getRocketNodeManager(rp)
     if err != nil {
         return nil, err
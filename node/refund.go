@@ -0,0 +1,203 @@
+package node
+
+import (
+    "context"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const RefundBalanceBatchSize = 20
+
+
+// A minipool node refund balance
+type MinipoolRefundBalance struct {
+    Minipool common.Address     `json:"minipool"`
+    RefundBalance *big.Int      `json:"refundBalance"`
+}
+
+
+// A minipool node refund
+// Refunds are skipped if their estimated gas cost is not less than the refund balance
+type MinipoolRefund struct {
+    MinipoolRefundBalance
+    GasEstimate uint64          `json:"gasEstimate"`
+    EstimatedGasCost *big.Int   `json:"estimatedGasCost"`
+    Skipped bool                `json:"skipped"`
+    Refunded bool               `json:"refunded"`
+    TxHash common.Hash          `json:"txHash"`
+    GasUsed uint64              `json:"gasUsed"`
+    GasCost *big.Int            `json:"gasCost"`
+    Error string                `json:"error,omitempty"`
+}
+
+
+// A summary of node minipool refunds
+type RefundSummary struct {
+    Refunds []MinipoolRefund    `json:"refunds"`
+    TotalRefunded *big.Int      `json:"totalRefunded"`
+    TotalGasCost *big.Int       `json:"totalGasCost"`
+    NetRecovered *big.Int       `json:"netRecovered"`
+}
+
+
+// Get the node refund balances of a node's minipools with a non-zero refund balance
+func GetNodeMinipoolRefundBalances(rp *rocketpool.RocketPool, nodeAddress common.Address, opts *bind.CallOpts) ([]MinipoolRefundBalance, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return []MinipoolRefundBalance{}, err
+    }
+
+    // Get minipool addresses
+    minipoolAddresses, err := minipool.GetNodeMinipoolAddresses(rp, nodeAddress, pinnedOpts)
+    if err != nil {
+        return []MinipoolRefundBalance{}, err
+    }
+
+    // Load refund balances in batches
+    refundBalances := make([]*big.Int, len(minipoolAddresses))
+    for bsi := 0; bsi < len(minipoolAddresses); bsi += RefundBalanceBatchSize {
+
+        // Get batch start & end index
+        msi := bsi
+        mei := bsi + RefundBalanceBatchSize
+        if mei > len(minipoolAddresses) { mei = len(minipoolAddresses) }
+
+        // Load refund balances
+        var wg errgroup.Group
+        for mi := msi; mi < mei; mi++ {
+            mi := mi
+            wg.Go(func() error {
+                mp, err := minipool.NewMinipool(rp, minipoolAddresses[mi])
+                if err != nil {
+                    return err
+                }
+                refundBalance, err := mp.GetNodeRefundBalance(pinnedOpts)
+                if err == nil { refundBalances[mi] = refundBalance }
+                return err
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return []MinipoolRefundBalance{}, err
+        }
+
+    }
+
+    // Filter minipools by refund balance
+    balances := []MinipoolRefundBalance{}
+    for mi, minipoolAddress := range minipoolAddresses {
+        if refundBalances[mi].Sign() > 0 { balances = append(balances, MinipoolRefundBalance{Minipool: minipoolAddress, RefundBalance: refundBalances[mi]}) }
+    }
+
+    // Return
+    return balances, nil
+
+}
+
+
+// Refund node ETH from all of the sending node's minipools where the refund balance exceeds the estimated gas cost
+// Refund transactions are sent sequentially with consecutive nonces
+func RefundMinipools(rp *rocketpool.RocketPool, opts *bind.TransactOpts) (RefundSummary, error) {
+
+    // Get minipool refund balances
+    refundBalances, err := GetNodeMinipoolRefundBalances(rp, opts.From, nil)
+    if err != nil {
+        return RefundSummary{}, err
+    }
+
+    // Initialize summary
+    summary := RefundSummary{
+        Refunds: []MinipoolRefund{},
+        TotalRefunded: big.NewInt(0),
+        TotalGasCost: big.NewInt(0),
+        NetRecovered: big.NewInt(0),
+    }
+    if len(refundBalances) == 0 {
+        return summary, nil
+    }
+
+    // Get gas price & starting nonce
+    gasPrice := opts.GasPrice
+    if gasPrice == nil {
+        if gasPrice, err = rp.Client.SuggestGasPrice(context.Background()); err != nil {
+            return RefundSummary{}, fmt.Errorf("Could not get gas price: %w", err)
+        }
+    }
+    var nonce uint64
+    if opts.Nonce != nil {
+        nonce = opts.Nonce.Uint64()
+    } else if nonce, err = rp.Client.PendingNonceAt(context.Background(), opts.From); err != nil {
+        return RefundSummary{}, fmt.Errorf("Could not get node %s account nonce: %w", opts.From.Hex(), err)
+    }
+
+    // Refund minipools
+    for _, refundBalance := range refundBalances {
+        refund := MinipoolRefund{MinipoolRefundBalance: refundBalance}
+
+        // Get transaction options
+        txOpts := *opts
+        txOpts.GasPrice = gasPrice
+        txOpts.GasLimit = 0
+        txOpts.Nonce = new(big.Int).SetUint64(nonce)
+
+        // Estimate gas cost & check refund is worthwhile
+        mp, err := minipool.NewMinipool(rp, refundBalance.Minipool)
+        if err != nil {
+            return RefundSummary{}, err
+        }
+        refund.GasEstimate, err = mp.EstimateRefundGas(&txOpts)
+        if err != nil {
+            refund.Error = err.Error()
+            summary.Refunds = append(summary.Refunds, refund)
+            continue
+        }
+        refund.EstimatedGasCost = new(big.Int).Mul(new(big.Int).SetUint64(refund.GasEstimate), gasPrice)
+        if refund.EstimatedGasCost.Cmp(refundBalance.RefundBalance) >= 0 {
+            refund.Skipped = true
+            summary.Refunds = append(summary.Refunds, refund)
+            continue
+        }
+
+        // Refund
+        txReceipt, err := mp.Refund(&txOpts)
+        if txReceipt != nil {
+            refund.TxHash = txReceipt.TxHash
+            refund.GasUsed = txReceipt.GasUsed
+            refund.GasCost = new(big.Int).Mul(new(big.Int).SetUint64(txReceipt.GasUsed), gasPrice)
+            summary.TotalGasCost.Add(summary.TotalGasCost, refund.GasCost)
+            nonce++
+        }
+        if err != nil {
+            refund.Error = err.Error()
+            if txReceipt == nil {
+                if nonce, err = rp.Client.PendingNonceAt(context.Background(), opts.From); err != nil {
+                    summary.Refunds = append(summary.Refunds, refund)
+                    summary.NetRecovered.Sub(summary.TotalRefunded, summary.TotalGasCost)
+                    return summary, fmt.Errorf("Could not get node %s account nonce: %w", opts.From.Hex(), err)
+                }
+            }
+        } else {
+            refund.Refunded = true
+            summary.TotalRefunded.Add(summary.TotalRefunded, refundBalance.RefundBalance)
+        }
+        summary.Refunds = append(summary.Refunds, refund)
+
+    }
+
+    // Return
+    summary.NetRecovered.Sub(summary.TotalRefunded, summary.TotalGasCost)
+    return summary, nil
+
+}
+
@@ -0,0 +1,192 @@
+package node
+
+import (
+    "context"
+    "fmt"
+    "sort"
+    "strings"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const InvalidTimezoneGroup = "Invalid"
+
+
+// A group of nodes in a geography report
+type GeographyGroup struct {
+    Name string                 `json:"name"`
+    NodeCount uint64            `json:"nodeCount"`
+    TrustedNodeCount uint64     `json:"trustedNodeCount"`
+    NodeShare float64           `json:"nodeShare"`
+}
+
+
+// Node geography report
+// Regions are the area component of IANA timezone names; UTC offsets are calculated at the report time
+// Nodes with invalid timezone locations are grouped under InvalidTimezoneGroup
+type GeographyReport struct {
+    Block uint64                    `json:"block"`
+    Time time.Time                  `json:"time"`
+    NodeCount uint64                `json:"nodeCount"`
+    TrustedNodeCount uint64         `json:"trustedNodeCount"`
+    Timezones []GeographyGroup      `json:"timezones"`
+    Regions []GeographyGroup        `json:"regions"`
+    UTCOffsets []GeographyGroup     `json:"utcOffsets"`
+}
+
+
+// Validate a node timezone location against the IANA timezone database
+// The system timezone database is used; binaries run on systems without one should import time/tzdata in their main package
+func ValidateTimezoneLocation(timezoneLocation string) error {
+    if timezoneLocation == "" || timezoneLocation == "Local" {
+        return fmt.Errorf("Invalid timezone location '%s'", timezoneLocation)
+    }
+    if _, err := time.LoadLocation(timezoneLocation); err != nil {
+        return fmt.Errorf("Invalid timezone location '%s': %w", timezoneLocation, err)
+    }
+    return nil
+}
+
+
+// Get a geography report for all registered nodes
+func GetGeographyReport(rp *rocketpool.RocketPool, opts *bind.CallOpts) (GeographyReport, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return GeographyReport{}, err
+    }
+
+    // Get nodes & block time
+    nodes, err := GetNodes(rp, pinnedOpts)
+    if err != nil {
+        return GeographyReport{}, err
+    }
+    header, err := rp.Client.HeaderByNumber(context.Background(), pinnedOpts.BlockNumber)
+    if err != nil {
+        return GeographyReport{}, err
+    }
+
+    // Return
+    report := NewGeographyReport(nodes, time.Unix(int64(header.Time), 0))
+    report.Block = pinnedOpts.BlockNumber.Uint64()
+    return report, nil
+
+}
+
+
+// Create a geography report from node details at a time
+func NewGeographyReport(nodes []NodeDetails, at time.Time) GeographyReport {
+
+    // Group nodes
+    timezones := newGeographyGroups()
+    regions := newGeographyGroups()
+    offsets := newGeographyGroups()
+    offsetSeconds := make(map[string]int)
+    var nodeCount, trustedNodeCount uint64
+    for _, node := range nodes {
+        if !node.Exists { continue }
+        nodeCount++
+        if node.Trusted { trustedNodeCount++ }
+
+        // Get timezone location
+        if err := ValidateTimezoneLocation(node.TimezoneLocation); err != nil {
+            timezones.add(InvalidTimezoneGroup, node.Trusted)
+            regions.add(InvalidTimezoneGroup, node.Trusted)
+            offsets.add(InvalidTimezoneGroup, node.Trusted)
+            continue
+        }
+
+        // Add node to groups
+        location, _ := time.LoadLocation(node.TimezoneLocation)
+        _, offset := at.In(location).Zone()
+        offsetName := formatUTCOffset(offset)
+        offsetSeconds[offsetName] = offset
+        timezones.add(location.String(), node.Trusted)
+        regions.add(getTimezoneRegion(location.String()), node.Trusted)
+        offsets.add(offsetName, node.Trusted)
+
+    }
+
+    // Sort offsets by offset, with invalid timezones last
+    utcOffsets := offsets.list(nodeCount)
+    sort.SliceStable(utcOffsets, func(i, j int) bool {
+        iOffset, iValid := offsetSeconds[utcOffsets[i].Name]
+        jOffset, jValid := offsetSeconds[utcOffsets[j].Name]
+        if iValid != jValid { return iValid }
+        return iOffset < jOffset
+    })
+
+    // Return
+    return GeographyReport{
+        Time: at,
+        NodeCount: nodeCount,
+        TrustedNodeCount: trustedNodeCount,
+        Timezones: timezones.list(nodeCount),
+        Regions: regions.list(nodeCount),
+        UTCOffsets: utcOffsets,
+    }
+
+}
+
+
+// Geography groups by name
+type geographyGroups map[string]*GeographyGroup
+func newGeographyGroups() geographyGroups {
+    return make(geographyGroups)
+}
+
+
+// Add a node to a group
+func (g geographyGroups) add(name string, trusted bool) {
+    group, ok := g[name]
+    if !ok {
+        group = &GeographyGroup{Name: name}
+        g[name] = group
+    }
+    group.NodeCount++
+    if trusted { group.TrustedNodeCount++ }
+}
+
+
+// Get groups with node shares, sorted by node count descending then name
+func (g geographyGroups) list(nodeCount uint64) []GeographyGroup {
+    groups := []GeographyGroup{}
+    for _, group := range g {
+        if nodeCount > 0 { group.NodeShare = float64(group.NodeCount) / float64(nodeCount) }
+        groups = append(groups, *group)
+    }
+    sort.Slice(groups, func(i, j int) bool {
+        if groups[i].NodeCount != groups[j].NodeCount { return groups[i].NodeCount > groups[j].NodeCount }
+        return groups[i].Name < groups[j].Name
+    })
+    return groups
+}
+
+
+// Get the region of an IANA timezone name
+// Names without an area component (e.g. "UTC") are grouped under "Etc"
+func getTimezoneRegion(timezoneLocation string) string {
+    if i := strings.Index(timezoneLocation, "/"); i > 0 {
+        return timezoneLocation[:i]
+    }
+    return "Etc"
+}
+
+
+// Format a UTC offset in seconds
+func formatUTCOffset(offset int) string {
+    sign := "+"
+    if offset < 0 {
+        sign = "-"
+        offset = -offset
+    }
+    return fmt.Sprintf("UTC%s%02d:%02d", sign, offset / 3600, (offset % 3600) / 60)
+}
+
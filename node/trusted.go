@@ -0,0 +1,182 @@
+package node
+
+import (
+    "fmt"
+    "math/big"
+    "time"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Node events
+type nodeTrustedSet struct {
+    Node common.Address
+    Trusted bool
+    Time *big.Int
+}
+
+
+// Trusted node quorum
+// Consensus is reached when the submission count divided by the trusted node count meets the consensus threshold
+type TrustedNodeQuorum struct {
+    Block uint64                    `json:"block"`
+    TrustedNodeCount uint64         `json:"trustedNodeCount"`
+    ConsensusThreshold float64      `json:"consensusThreshold"`
+    RequiredSubmissions uint64      `json:"requiredSubmissions"`
+    Reachable bool                  `json:"reachable"`
+}
+
+
+// A change in trusted node membership
+// TrustedNodeCount is the number of trusted nodes after the change
+type TrustedNodeChange struct {
+    Node common.Address             `json:"node"`
+    Trusted bool                    `json:"trusted"`
+    Block uint64                    `json:"block"`
+    Time time.Time                  `json:"time"`
+    TxHash common.Hash              `json:"txHash"`
+    TrustedNodeCount uint64         `json:"trustedNodeCount"`
+}
+
+
+// Trusted node membership history
+type TrustedNodeHistory struct {
+    Block uint64                    `json:"block"`
+    Changes []TrustedNodeChange     `json:"changes"`
+    Members []common.Address        `json:"members"`
+}
+
+
+// Get the number of trusted node submissions required to reach consensus
+// Mirrors the network's integer arithmetic; returns trustedNodeCount + 1 if consensus cannot be reached
+func GetRequiredSubmissionCount(trustedNodeCount uint64, consensusThreshold float64) uint64 {
+    if trustedNodeCount == 0 { return 1 }
+
+    // Get threshold & node count
+    threshold := eth.EthToWei(consensusThreshold)
+    nodeCount := new(big.Int).SetUint64(trustedNodeCount)
+
+    // Get lowest submission count meeting threshold
+    for submissions := uint64(1); submissions <= trustedNodeCount; submissions++ {
+        ratio := new(big.Int).Mul(eth.EthToWei(1), new(big.Int).SetUint64(submissions))
+        ratio.Div(ratio, nodeCount)
+        if ratio.Cmp(threshold) >= 0 { return submissions }
+    }
+    return trustedNodeCount + 1
+
+}
+
+
+// Get the current trusted node quorum
+func GetTrustedNodeQuorum(rp *rocketpool.RocketPool, opts *bind.CallOpts) (TrustedNodeQuorum, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return TrustedNodeQuorum{}, err
+    }
+
+    // Data
+    var wg errgroup.Group
+    var trustedNodeCount uint64
+    var consensusThreshold float64
+
+    // Load data
+    wg.Go(func() error {
+        var err error
+        trustedNodeCount, err = GetTrustedNodeCount(rp, pinnedOpts)
+        return err
+    })
+    wg.Go(func() error {
+        var err error
+        consensusThreshold, err = settings.GetNodeConsensusThreshold(rp, pinnedOpts)
+        return err
+    })
+
+    // Wait for data
+    if err := wg.Wait(); err != nil {
+        return TrustedNodeQuorum{}, err
+    }
+
+    // Return
+    requiredSubmissions := GetRequiredSubmissionCount(trustedNodeCount, consensusThreshold)
+    return TrustedNodeQuorum{
+        Block: pinnedOpts.BlockNumber.Uint64(),
+        TrustedNodeCount: trustedNodeCount,
+        ConsensusThreshold: consensusThreshold,
+        RequiredSubmissions: requiredSubmissions,
+        Reachable: (trustedNodeCount > 0 && requiredSubmissions <= trustedNodeCount),
+    }, nil
+
+}
+
+
+// Get the history of trusted node membership changes from network events
+// Changes which do not alter a node's trusted status are omitted
+func GetTrustedNodeHistory(rp *rocketpool.RocketPool, opts *bind.CallOpts) (TrustedNodeHistory, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(rp.Client, opts)
+    if err != nil {
+        return TrustedNodeHistory{}, err
+    }
+
+    // Get node trusted set events
+    rocketNodeManager, err := getRocketNodeManager(rp)
+    if err != nil {
+        return TrustedNodeHistory{}, err
+    }
+    events, err := rocketNodeManager.GetEvents("NodeTrustedSet", nodeTrustedSet{}, new(big.Int).SetUint64(rp.DeployBlock), pinnedOpts.BlockNumber)
+    if err != nil {
+        return TrustedNodeHistory{}, fmt.Errorf("Could not get node trusted set events: %w", err)
+    }
+
+    // Replay membership changes
+    changes := []TrustedNodeChange{}
+    trusted := make(map[common.Address]bool)
+    members := []common.Address{}
+    for _, event := range events {
+        nodeTrusted := event.Event.(nodeTrustedSet)
+        if trusted[nodeTrusted.Node] == nodeTrusted.Trusted { continue }
+        trusted[nodeTrusted.Node] = nodeTrusted.Trusted
+
+        // Update members
+        if nodeTrusted.Trusted {
+            members = append(members, nodeTrusted.Node)
+        } else {
+            for mi, member := range members {
+                if member == nodeTrusted.Node {
+                    members = append(members[:mi], members[mi + 1:]...)
+                    break
+                }
+            }
+        }
+
+        // Add change
+        changes = append(changes, TrustedNodeChange{
+            Node: nodeTrusted.Node,
+            Trusted: nodeTrusted.Trusted,
+            Block: event.Log.BlockNumber,
+            Time: time.Unix(nodeTrusted.Time.Int64(), 0),
+            TxHash: event.Log.TxHash,
+            TrustedNodeCount: uint64(len(members)),
+        })
+
+    }
+
+    // Return
+    return TrustedNodeHistory{
+        Block: pinnedOpts.BlockNumber.Uint64(),
+        Changes: changes,
+        Members: members,
+    }, nil
+
+}
+
@@ -0,0 +1,98 @@
+package operator
+
+import (
+    "crypto/ecdsa"
+    "crypto/hmac"
+    "crypto/sha512"
+    "encoding/binary"
+    "errors"
+    "fmt"
+    "math/big"
+    "strings"
+
+    "github.com/ethereum/go-ethereum/accounts"
+    "github.com/ethereum/go-ethereum/common/math"
+    "github.com/ethereum/go-ethereum/crypto"
+    "github.com/tyler-smith/go-bip39"
+    "golang.org/x/crypto/pbkdf2"
+    "golang.org/x/text/unicode/norm"
+)
+
+
+// Settings
+const (
+    mnemonicSeedIterations = 2048
+    mnemonicSeedLength = 64
+)
+
+
+// Check a mnemonic's words against the BIP-39 English word list and verify its checksum
+func validateMnemonic(mnemonic string) error {
+    if _, err := bip39.EntropyFromMnemonic(norm.NFKD.String(mnemonic)); err != nil {
+        return fmt.Errorf("Invalid mnemonic: %w", err)
+    }
+    return nil
+}
+
+
+// Get a BIP-39 seed from a mnemonic and passphrase
+// The mnemonic and passphrase are NFKD normalised and mnemonic whitespace is collapsed
+func mnemonicToSeed(mnemonic, passphrase string) []byte {
+    normalised := norm.NFKD.String(strings.Join(strings.Fields(mnemonic), " "))
+    salt := norm.NFKD.String("mnemonic" + passphrase)
+    return pbkdf2.Key([]byte(normalised), []byte(salt), mnemonicSeedIterations, mnemonicSeedLength, sha512.New)
+}
+
+
+// Derive a BIP-32 private key from a seed along a derivation path
+func derivePrivateKey(seed []byte, path accounts.DerivationPath) (*ecdsa.PrivateKey, error) {
+
+    // Get master key & chain code
+    mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+    mac.Write(seed)
+    sum := mac.Sum(nil)
+    key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+    curveOrder := crypto.S256().Params().N
+    if key.Sign() == 0 || key.Cmp(curveOrder) >= 0 {
+        return nil, errors.New("Invalid master key derived from seed")
+    }
+
+    // Derive child keys
+    for _, index := range path {
+
+        // Get child key data
+        var data []byte
+        if index >= 0x80000000 {
+            data = append([]byte{0}, math.PaddedBigBytes(key, 32)...)
+        } else {
+            privateKey, err := crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+            if err != nil {
+                return nil, err
+            }
+            data = crypto.CompressPubkey(&privateKey.PublicKey)
+        }
+        indexBytes := make([]byte, 4)
+        binary.BigEndian.PutUint32(indexBytes, index)
+        data = append(data, indexBytes...)
+
+        // Get child key & chain code
+        mac := hmac.New(sha512.New, chainCode)
+        mac.Write(data)
+        sum := mac.Sum(nil)
+        tweak := new(big.Int).SetBytes(sum[:32])
+        if tweak.Cmp(curveOrder) >= 0 {
+            return nil, errors.New("Invalid child key derived from seed")
+        }
+        key = new(big.Int).Mod(new(big.Int).Add(tweak, key), curveOrder)
+        if key.Sign() == 0 {
+            return nil, errors.New("Invalid child key derived from seed")
+        }
+        chainCode = sum[32:]
+
+    }
+
+    // Return
+    return crypto.ToECDSA(math.PaddedBigBytes(key, 32))
+
+}
+
@@ -0,0 +1,191 @@
+package operator
+
+import (
+    "context"
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/core/types"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// The result of an operation on a node
+type NodeResult struct {
+    Node common.Address         `json:"node"`
+    Result interface{}          `json:"result,omitempty"`
+    Error string                `json:"error,omitempty"`
+}
+
+
+// An operation run on a node with transaction options for its account
+// Each transaction signed with the options advances their nonce and resets their gas limit, so operations may send several transactions in sequence
+type NodeOperation func(account *NodeAccount, opts *bind.TransactOpts) (interface{}, error)
+
+
+// Run an operation on a set of node accounts
+// All transactions use the same gas price; nonces are tracked per account and operations on the same account never overlap
+// Operation errors are returned in the per-node results
+func (m *Manager) Run(accounts []*NodeAccount, operation NodeOperation) ([]NodeResult, error) {
+
+    // Get gas price & chain ID
+    gasPrice, err := m.GetGasPrice()
+    if err != nil {
+        return []NodeResult{}, err
+    }
+    chainID := m.config.ChainID
+    if chainID == nil {
+        if chainID, err = m.rp.Client.ChainID(context.Background()); err != nil {
+            return []NodeResult{}, fmt.Errorf("Could not get chain ID: %w", err)
+        }
+    }
+
+    // Run operation
+    results := make([]NodeResult, len(accounts))
+    m.forEachAccount(accounts, func(ai int, account *NodeAccount) error {
+        results[ai] = NodeResult{Node: account.Address}
+        result, err := m.runOperation(account, chainID, gasPrice, operation)
+        if err != nil {
+            results[ai].Error = err.Error()
+        } else {
+            results[ai].Result = result
+        }
+        return nil
+    })
+
+    // Return
+    return results, nil
+
+}
+
+
+// Refund node ETH from the minipools of a set of node accounts
+// Results are node.RefundSummary values
+func (m *Manager) RefundMinipools(accounts []*NodeAccount) ([]NodeResult, error) {
+    return m.Run(accounts, func(account *NodeAccount, opts *bind.TransactOpts) (interface{}, error) {
+        return node.RefundMinipools(m.rp, opts)
+    })
+}
+
+
+// Set the timezone location of a set of node accounts
+// Results are transaction hashes
+func (m *Manager) SetTimezoneLocation(accounts []*NodeAccount, timezoneLocation string) ([]NodeResult, error) {
+    if err := node.ValidateTimezoneLocation(timezoneLocation); err != nil {
+        return []NodeResult{}, err
+    }
+    return m.Run(accounts, func(account *NodeAccount, opts *bind.TransactOpts) (interface{}, error) {
+        txReceipt, err := node.SetTimezoneLocation(m.rp, timezoneLocation, opts)
+        if err != nil {
+            return nil, err
+        }
+        return txReceipt.TxHash, nil
+    })
+}
+
+
+// Get status reports for a set of node accounts, loaded at a single block
+// Results are node.NodeDashboard values
+func (m *Manager) GetStatusReports(accounts []*NodeAccount, opts *bind.CallOpts) ([]NodeResult, error) {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(m.rp.Client, opts)
+    if err != nil {
+        return []NodeResult{}, err
+    }
+
+    // Get node dashboards
+    results := make([]NodeResult, len(accounts))
+    m.forEachAccount(accounts, func(ai int, account *NodeAccount) error {
+        results[ai] = NodeResult{Node: account.Address}
+        dashboard, err := node.GetNodeDashboard(m.rp, account.Address, pinnedOpts)
+        if err != nil {
+            results[ai].Error = err.Error()
+        } else {
+            results[ai].Result = dashboard
+        }
+        return nil
+    })
+
+    // Return
+    return results, nil
+
+}
+
+
+// Run an operation on a node account with a tracked nonce
+// The tracked nonce is discarded if the operation fails, as its signed transactions may not have been sent
+func (m *Manager) runOperation(account *NodeAccount, chainID, gasPrice *big.Int, operation NodeOperation) (interface{}, error) {
+    account.lock.Lock()
+    defer account.lock.Unlock()
+
+    // Get transaction options
+    opts, err := m.getTransactOpts(account, chainID, gasPrice)
+    if err != nil {
+        return nil, err
+    }
+
+    // Run operation
+    result, err := operation(account, opts)
+    if err != nil {
+        account.nonce = nil
+    }
+    return result, err
+
+}
+
+
+// Get transaction options for a node account which allocate consecutive nonces to the transactions they sign
+// Signed transaction nonces are recorded as the account's tracked nonce
+// Gas limits estimated onto the options are reset after signing, so each transaction is estimated separately
+func (m *Manager) getTransactOpts(account *NodeAccount, chainID, gasPrice *big.Int) (*bind.TransactOpts, error) {
+
+    // Get keyed transaction options & starting nonce
+    opts, err := bind.NewKeyedTransactorWithChainID(account.privateKey, chainID)
+    if err != nil {
+        return nil, err
+    }
+    nonce, err := m.getNonce(account)
+    if err != nil {
+        return nil, err
+    }
+    opts.GasPrice = gasPrice
+    opts.Nonce = new(big.Int).SetUint64(nonce)
+
+    // Advance nonce & reset gas limit as transactions are signed
+    gasLimit := opts.GasLimit
+    signer := opts.Signer
+    opts.Signer = func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+        signedTx, err := signer(address, tx)
+        if err != nil {
+            return nil, err
+        }
+        nextNonce := tx.Nonce() + 1
+        if account.nonce == nil || *account.nonce < nextNonce { account.nonce = &nextNonce }
+        opts.Nonce = new(big.Int).SetUint64(*account.nonce)
+        opts.GasLimit = gasLimit
+        return signedTx, nil
+    }
+
+    // Return
+    return opts, nil
+
+}
+
+
+// Get the next nonce for a node account
+// Uses the higher of the tracked nonce and the pending nonce reported by the client, which may lag behind sent transactions
+func (m *Manager) getNonce(account *NodeAccount) (uint64, error) {
+    nonce, err := m.rp.Client.PendingNonceAt(context.Background(), account.Address)
+    if err != nil {
+        return 0, fmt.Errorf("Could not get node %s account nonce: %w", account.Address.Hex(), err)
+    }
+    if account.nonce != nil && *account.nonce > nonce { nonce = *account.nonce }
+    account.nonce = &nonce
+    return nonce, nil
+}
+
@@ -0,0 +1,290 @@
+package operator
+
+import (
+    "bytes"
+    "context"
+    "crypto/ecdsa"
+    "errors"
+    "fmt"
+    "io/ioutil"
+    "math/big"
+    "os"
+    "path/filepath"
+    "sort"
+    "sync"
+
+    "github.com/ethereum/go-ethereum/accounts"
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/accounts/keystore"
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Settings
+const (
+    DefaultConcurrency = 10
+    DefaultDerivationPath = "m/44'/60'/0'/0/%d"
+)
+
+
+// Errors
+var ErrGasPriceTooHigh = errors.New("Current gas price exceeds the maximum gas price")
+
+
+// Node manager settings
+// Nil or zero values use network defaults
+type ManagerConfig struct {
+    ChainID *big.Int        // Chain ID used to sign transactions
+    GasPrice *big.Int       // Fixed gas price for all transactions; suggested by the client if unset
+    MaxGasPrice *big.Int    // Operations are not run while the gas price is above this value
+    Concurrency int         // Maximum number of nodes operated on at once
+}
+
+
+// A node account
+// Node details are set when the manager's nodes are loaded
+type NodeAccount struct {
+    Address common.Address          `json:"address"`
+    DerivationPath string           `json:"derivationPath,omitempty"`
+    Node node.NodeDetails           `json:"node"`
+    privateKey *ecdsa.PrivateKey
+    nonce *uint64
+    lock sync.Mutex
+}
+
+
+// Manages many node accounts
+type Manager struct {
+    rp *rocketpool.RocketPool
+    config ManagerConfig
+    accounts []*NodeAccount
+    accountsLock sync.RWMutex
+}
+
+
+// Create a new node manager
+func NewManager(rp *rocketpool.RocketPool, config ManagerConfig) *Manager {
+    return &Manager{
+        rp: rp,
+        config: config,
+        accounts: []*NodeAccount{},
+    }
+}
+
+
+// Add a node account by private key
+// Returns the existing account if the address has already been added
+func (m *Manager) AddPrivateKey(privateKey *ecdsa.PrivateKey) *NodeAccount {
+    return m.addAccount(&NodeAccount{
+        Address: crypto.PubkeyToAddress(privateKey.PublicKey),
+        privateKey: privateKey,
+    })
+}
+
+
+// Load node accounts from a keystore file, or all keystore files in a directory, encrypted with a password
+func (m *Manager) LoadKeystore(path string, password string) ([]*NodeAccount, error) {
+
+    // Get keystore file paths
+    info, err := os.Stat(path)
+    if err != nil {
+        return []*NodeAccount{}, fmt.Errorf("Could not read keystore path %s: %w", path, err)
+    }
+    paths := []string{path}
+    if info.IsDir() {
+        files, err := ioutil.ReadDir(path)
+        if err != nil {
+            return []*NodeAccount{}, fmt.Errorf("Could not read keystore directory %s: %w", path, err)
+        }
+        paths = []string{}
+        for _, file := range files {
+            if file.IsDir() || file.Name()[0] == '.' { continue }
+            paths = append(paths, filepath.Join(path, file.Name()))
+        }
+    }
+
+    // Decrypt keystore files
+    loaded := []*NodeAccount{}
+    for _, keyPath := range paths {
+        keyJson, err := ioutil.ReadFile(keyPath)
+        if err != nil {
+            return []*NodeAccount{}, fmt.Errorf("Could not read keystore file %s: %w", keyPath, err)
+        }
+        key, err := keystore.DecryptKey(keyJson, password)
+        if err != nil {
+            return []*NodeAccount{}, fmt.Errorf("Could not decrypt keystore file %s: %w", keyPath, err)
+        }
+        loaded = append(loaded, m.AddPrivateKey(key.PrivateKey))
+    }
+
+    // Return
+    return loaded, nil
+
+}
+
+
+// Load node accounts derived from an HD wallet mnemonic
+// The derivation path must contain a single %d placeholder for the account index, e.g. DefaultDerivationPath
+// Mnemonics with words outside the BIP-39 English word list or an incorrect checksum are rejected
+func (m *Manager) LoadMnemonic(mnemonic, passphrase, derivationPath string, startIndex, count uint) ([]*NodeAccount, error) {
+    if err := validateMnemonic(mnemonic); err != nil {
+        return []*NodeAccount{}, err
+    }
+    seed := mnemonicToSeed(mnemonic, passphrase)
+    loaded := []*NodeAccount{}
+    for index := startIndex; index < startIndex + count; index++ {
+
+        // Get derivation path
+        pathString := fmt.Sprintf(derivationPath, index)
+        path, err := accounts.ParseDerivationPath(pathString)
+        if err != nil {
+            return []*NodeAccount{}, fmt.Errorf("Invalid derivation path %s: %w", pathString, err)
+        }
+
+        // Derive private key & add account
+        privateKey, err := derivePrivateKey(seed, path)
+        if err != nil {
+            return []*NodeAccount{}, fmt.Errorf("Could not derive private key at %s: %w", pathString, err)
+        }
+        account := m.addAccount(&NodeAccount{
+            Address: crypto.PubkeyToAddress(privateKey.PublicKey),
+            DerivationPath: pathString,
+            privateKey: privateKey,
+        })
+        loaded = append(loaded, account)
+
+    }
+    return loaded, nil
+}
+
+
+// Load the registered node details of all accounts
+func (m *Manager) LoadNodes(opts *bind.CallOpts) error {
+
+    // Pin call options to block
+    pinnedOpts, err := eth.PinCallOpts(m.rp.Client, opts)
+    if err != nil {
+        return err
+    }
+
+    // Load node details
+    accounts := m.GetAccounts()
+    details := make([]node.NodeDetails, len(accounts))
+    if err := m.forEachAccount(accounts, func(ai int, account *NodeAccount) error {
+        var err error
+        details[ai], err = node.GetNodeDetails(m.rp, account.Address, pinnedOpts)
+        return err
+    }); err != nil {
+        return err
+    }
+
+    // Set node details
+    m.accountsLock.Lock()
+    defer m.accountsLock.Unlock()
+    for ai, account := range accounts {
+        account.Node = details[ai]
+    }
+    return nil
+
+}
+
+
+// Get all node accounts, ordered by address
+func (m *Manager) GetAccounts() []*NodeAccount {
+    m.accountsLock.RLock()
+    defer m.accountsLock.RUnlock()
+    accounts := make([]*NodeAccount, len(m.accounts))
+    copy(accounts, m.accounts)
+    return accounts
+}
+
+
+// Get all node accounts which are registered nodes
+// Requires node details to have been loaded
+func (m *Manager) GetRegisteredAccounts() []*NodeAccount {
+    m.accountsLock.RLock()
+    defer m.accountsLock.RUnlock()
+    registered := []*NodeAccount{}
+    for _, account := range m.accounts {
+        if account.Node.Exists { registered = append(registered, account) }
+    }
+    return registered
+}
+
+
+// Get a node account by address
+func (m *Manager) GetAccount(address common.Address) (*NodeAccount, bool) {
+    m.accountsLock.RLock()
+    defer m.accountsLock.RUnlock()
+    for _, account := range m.accounts {
+        if bytes.Equal(account.Address.Bytes(), address.Bytes()) { return account, true }
+    }
+    return nil, false
+}
+
+
+// Get the gas price to use for node transactions and check it against the maximum
+func (m *Manager) GetGasPrice() (*big.Int, error) {
+    gasPrice := m.config.GasPrice
+    if gasPrice == nil {
+        var err error
+        gasPrice, err = m.rp.Client.SuggestGasPrice(context.Background())
+        if err != nil {
+            return nil, fmt.Errorf("Could not get gas price: %w", err)
+        }
+    }
+    if m.config.MaxGasPrice != nil && gasPrice.Cmp(m.config.MaxGasPrice) > 0 {
+        return nil, ErrGasPriceTooHigh
+    }
+    return gasPrice, nil
+}
+
+
+// Add a node account, returning the existing account if the address has already been added
+func (m *Manager) addAccount(account *NodeAccount) *NodeAccount {
+    m.accountsLock.Lock()
+    defer m.accountsLock.Unlock()
+    for _, existing := range m.accounts {
+        if bytes.Equal(existing.Address.Bytes(), account.Address.Bytes()) { return existing }
+    }
+    m.accounts = append(m.accounts, account)
+    sort.Slice(m.accounts, func(i, j int) bool {
+        return bytes.Compare(m.accounts[i].Address.Bytes(), m.accounts[j].Address.Bytes()) < 0
+    })
+    return account
+}
+
+
+// Run a function for each of a set of accounts, with limited concurrency
+func (m *Manager) forEachAccount(accounts []*NodeAccount, fn func(int, *NodeAccount) error) error {
+    concurrency := m.config.Concurrency
+    if concurrency <= 0 { concurrency = DefaultConcurrency }
+    for bsi := 0; bsi < len(accounts); bsi += concurrency {
+
+        // Get batch start & end index
+        asi := bsi
+        aei := bsi + concurrency
+        if aei > len(accounts) { aei = len(accounts) }
+
+        // Run function
+        var wg errgroup.Group
+        for ai := asi; ai < aei; ai++ {
+            ai := ai
+            wg.Go(func() error {
+                return fn(ai, accounts[ai])
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return err
+        }
+
+    }
+    return nil
+}
+
@@ -5,6 +5,7 @@ import (
     "context"
     "errors"
     "fmt"
+    "math/big"
     "reflect"
 
     "github.com/ethereum/go-ethereum"
@@ -23,6 +24,17 @@ const (
 )
 
 
+// Event log settings
+const EventLogPageSize = 10000 // blocks
+
+
+// Contract event with its log
+type EventLog struct {
+    Event interface{}
+    Log types.Log
+}
+
+
 // Contract type wraps go-ethereum bound contract
 type Contract struct {
     Contract *bind.BoundContract
@@ -92,11 +104,36 @@ func (c *Contract) Transfer(opts *bind.TransactOpts) (*types.Receipt, error) {
 }
 
 
+// Estimate the gas used by a contract method transaction
+func (c *Contract) EstimateGas(opts *bind.TransactOpts, method string, params ...interface{}) (uint64, error) {
+    input, err := c.ABI.Pack(method, params...)
+    if err != nil {
+        return 0, fmt.Errorf("Could not encode input data: %w", err)
+    }
+    return c.estimateGas(opts, input)
+}
+
+
 // Estimate the gas limit for a contract transaction
 func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint64, error) {
 
     // Estimate gas limit
-    gasLimit, err := c.Client.EstimateGas(context.Background(), ethereum.CallMsg{
+    gasLimit, err := c.estimateGas(opts, input)
+    if err != nil {
+        return 0, err
+    }
+
+    // Pad and return gas limit
+    gasLimit += GasLimitPadding
+    if gasLimit > MaxGasLimit { gasLimit = MaxGasLimit }
+    return gasLimit, nil
+
+}
+
+
+// Estimate the gas used by a contract transaction
+func (c *Contract) estimateGas(opts *bind.TransactOpts, input []byte) (uint64, error) {
+    gas, err := c.Client.EstimateGas(context.Background(), ethereum.CallMsg{
         From: opts.From,
         To: c.Address,
         GasPrice: opts.GasPrice,
@@ -106,12 +143,7 @@ func (c *Contract) estimateGasLimit(opts *bind.TransactOpts, input []byte) (uint
     if err != nil {
         return 0, fmt.Errorf("Could not estimate gas needed: %w", err)
     }
-
-    // Pad and return gas limit
-    gasLimit += GasLimitPadding
-    if gasLimit > MaxGasLimit { gasLimit = MaxGasLimit }
-    return gasLimit, nil
-
+    return gas, nil
 }
 
 
@@ -140,16 +172,10 @@ func (c *Contract) getTransactionReceipt(tx *types.Transaction) (*types.Receipt,
 // Returns a slice of untyped values; assert returned events to event struct type
 func (c *Contract) GetTransactionEvents(txReceipt *types.Receipt, eventName string, eventPrototype interface{}) ([]interface{}, error) {
 
-    // Get event type
-    eventType := reflect.TypeOf(eventPrototype)
-    if eventType.Kind() != reflect.Struct {
-        return nil, errors.New("Invalid event type")
-    }
-
-    // Get ABI event
-    abiEvent, ok := c.ABI.Events[eventName]
-    if !ok {
-        return nil, fmt.Errorf("Event '%s' does not exist on contract", eventName)
+    // Get event type & ABI event
+    eventType, abiEvent, err := c.getEventType(eventName, eventPrototype)
+    if err != nil {
+        return nil, err
     }
 
     // Process transaction receipt logs
@@ -167,11 +193,11 @@ func (c *Contract) GetTransactionEvents(txReceipt *types.Receipt, eventName stri
         }
 
         // Unpack event
-        event := reflect.New(eventType)
-        if err := c.Contract.UnpackLog(event.Interface(), eventName, *log); err != nil {
-            return nil, fmt.Errorf("Could not unpack event data: %w", err)
+        event, err := c.unpackEvent(eventType, eventName, *log)
+        if err != nil {
+            return nil, err
         }
-        events = append(events, reflect.Indirect(event).Interface())
+        events = append(events, event)
 
     }
 
@@ -180,3 +206,87 @@ func (c *Contract) GetTransactionEvents(txReceipt *types.Receipt, eventName stri
 
 }
 
+
+// Get contract events within a block range
+// eventPrototype must be an event struct type
+// indexedTopics filter the event's indexed parameters in order; nil or empty filters match all values
+// Logs are filtered in pages of EventLogPageSize blocks; a nil toBlock filters up to the latest block
+// Returns event logs with untyped events; assert returned events to event struct type
+func (c *Contract) GetEvents(eventName string, eventPrototype interface{}, fromBlock, toBlock *big.Int, indexedTopics ...[]common.Hash) ([]EventLog, error) {
+
+    // Get event type & ABI event
+    eventType, abiEvent, err := c.getEventType(eventName, eventPrototype)
+    if err != nil {
+        return nil, err
+    }
+
+    // Get block range
+    startBlock := uint64(0)
+    if fromBlock != nil { startBlock = fromBlock.Uint64() }
+    var endBlock uint64
+    if toBlock != nil {
+        endBlock = toBlock.Uint64()
+    } else if endBlock, err = c.Client.BlockNumber(context.Background()); err != nil {
+        return nil, fmt.Errorf("Could not get latest block number: %w", err)
+    }
+
+    // Filter logs & unpack events in pages
+    events := []EventLog{}
+    for psi := startBlock; psi <= endBlock; psi += EventLogPageSize {
+
+        // Get page end block
+        pei := psi + EventLogPageSize - 1
+        if pei > endBlock { pei = endBlock }
+
+        // Filter logs
+        logs, err := c.Client.FilterLogs(context.Background(), ethereum.FilterQuery{
+            FromBlock: new(big.Int).SetUint64(psi),
+            ToBlock: new(big.Int).SetUint64(pei),
+            Addresses: []common.Address{*c.Address},
+            Topics: append([][]common.Hash{{abiEvent.ID}}, indexedTopics...),
+        })
+        if err != nil {
+            return nil, fmt.Errorf("Could not get '%s' event logs for blocks %d to %d: %w", eventName, psi, pei, err)
+        }
+
+        // Unpack events
+        for _, log := range logs {
+            if log.Removed { continue }
+            event, err := c.unpackEvent(eventType, eventName, log)
+            if err != nil {
+                return nil, err
+            }
+            events = append(events, EventLog{Event: event, Log: log})
+        }
+
+    }
+
+    // Return events
+    return events, nil
+
+}
+
+
+// Get an event's struct type and ABI event
+func (c *Contract) getEventType(eventName string, eventPrototype interface{}) (reflect.Type, abi.Event, error) {
+    eventType := reflect.TypeOf(eventPrototype)
+    if eventType.Kind() != reflect.Struct {
+        return nil, abi.Event{}, errors.New("Invalid event type")
+    }
+    abiEvent, ok := c.ABI.Events[eventName]
+    if !ok {
+        return nil, abi.Event{}, fmt.Errorf("Event '%s' does not exist on contract", eventName)
+    }
+    return eventType, abiEvent, nil
+}
+
+
+// Unpack an event log into an event struct value
+func (c *Contract) unpackEvent(eventType reflect.Type, eventName string, log types.Log) (interface{}, error) {
+    event := reflect.New(eventType)
+    if err := c.Contract.UnpackLog(event.Interface(), eventName, log); err != nil {
+        return nil, fmt.Errorf("Could not unpack event data: %w", err)
+    }
+    return reflect.Indirect(event).Interface(), nil
+}
+
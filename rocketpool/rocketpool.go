@@ -39,9 +39,11 @@ type cachedContract struct {
 
 
 // Rocket Pool contract manager
+// DeployBlock is the block the RocketStorage contract was deployed at; network event queries start from it
 type RocketPool struct {
     Client          *ethclient.Client
     RocketStorage   *contracts.RocketStorage
+    DeployBlock     uint64
     addresses       map[string]cachedAddress
     abis            map[string]cachedABI
     contracts       map[string]cachedContract
@@ -0,0 +1,112 @@
+package storage
+
+import (
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/common/math"
+    "github.com/ethereum/go-ethereum/crypto"
+)
+
+
+// Storage key builder
+// Values are packed as per Solidity's abi.encodePacked, and the key is the keccak256 hash of the packed data
+type KeyBuilder struct {
+    data []byte
+}
+
+
+// Create a new storage key builder
+func NewKey() *KeyBuilder {
+    return &KeyBuilder{data: []byte{}}
+}
+
+
+// Append a string value
+func (k *KeyBuilder) String(value string) *KeyBuilder {
+    k.data = append(k.data, []byte(value)...)
+    return k
+}
+
+
+// Append a dynamic bytes value
+func (k *KeyBuilder) Bytes(value []byte) *KeyBuilder {
+    k.data = append(k.data, value...)
+    return k
+}
+
+
+// Append a bytes32 value
+func (k *KeyBuilder) Bytes32(value [32]byte) *KeyBuilder {
+    k.data = append(k.data, value[:]...)
+    return k
+}
+
+
+// Append an address value
+func (k *KeyBuilder) Address(value common.Address) *KeyBuilder {
+    k.data = append(k.data, value.Bytes()...)
+    return k
+}
+
+
+// Append a bool value
+func (k *KeyBuilder) Bool(value bool) *KeyBuilder {
+    if value {
+        k.data = append(k.data, 1)
+    } else {
+        k.data = append(k.data, 0)
+    }
+    return k
+}
+
+
+// Append a uint8 value
+func (k *KeyBuilder) Uint8(value uint8) *KeyBuilder {
+    k.data = append(k.data, value)
+    return k
+}
+
+
+// Append a uint256 value
+func (k *KeyBuilder) Uint256(value *big.Int) *KeyBuilder {
+    k.data = append(k.data, math.U256Bytes(new(big.Int).Set(value))...)
+    return k
+}
+
+
+// Append a uint256 value from a uint64
+func (k *KeyBuilder) Uint(value uint64) *KeyBuilder {
+    return k.Uint256(new(big.Int).SetUint64(value))
+}
+
+
+// Get the packed key data
+func (k *KeyBuilder) Packed() []byte {
+    return k.data
+}
+
+
+// Get the storage key
+func (k *KeyBuilder) Hash() common.Hash {
+    return crypto.Keccak256Hash(k.data)
+}
+
+
+// Get a contract address storage key
+func ContractAddressKey(contractName string) common.Hash {
+    return NewKey().String("contract.address").String(contractName).Hash()
+}
+
+
+// Get a contract ABI storage key
+func ContractABIKey(contractName string) common.Hash {
+    return NewKey().String("contract.abi").String(contractName).Hash()
+}
+
+
+// Get a contract name storage key
+func ContractNameKey(contractAddress common.Address) common.Hash {
+    return NewKey().String("contract.name").Address(contractAddress).Hash()
+}
+
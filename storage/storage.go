@@ -0,0 +1,190 @@
+package storage
+
+import (
+    "fmt"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/common"
+    "golang.org/x/sync/errgroup"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+)
+
+
+// Settings
+const StorageBatchSize = 50
+
+
+// Get a uint value
+func GetUint(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (*big.Int, error) {
+    value, err := rp.RocketStorage.GetUint(opts, key)
+    if err != nil {
+        return nil, fmt.Errorf("Could not get storage uint value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetUints(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]*big.Int, error) {
+    values := make([]*big.Int, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetUint(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []*big.Int{}, err
+    }
+    return values, nil
+}
+
+
+// Get an int value
+func GetInt(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (*big.Int, error) {
+    value, err := rp.RocketStorage.GetInt(opts, key)
+    if err != nil {
+        return nil, fmt.Errorf("Could not get storage int value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetInts(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]*big.Int, error) {
+    values := make([]*big.Int, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetInt(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []*big.Int{}, err
+    }
+    return values, nil
+}
+
+
+// Get a bool value
+func GetBool(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (bool, error) {
+    value, err := rp.RocketStorage.GetBool(opts, key)
+    if err != nil {
+        return false, fmt.Errorf("Could not get storage bool value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetBools(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]bool, error) {
+    values := make([]bool, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetBool(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []bool{}, err
+    }
+    return values, nil
+}
+
+
+// Get an address value
+func GetAddress(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (common.Address, error) {
+    value, err := rp.RocketStorage.GetAddress(opts, key)
+    if err != nil {
+        return common.Address{}, fmt.Errorf("Could not get storage address value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetAddresses(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]common.Address, error) {
+    values := make([]common.Address, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetAddress(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []common.Address{}, err
+    }
+    return values, nil
+}
+
+
+// Get a string value
+func GetString(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (string, error) {
+    value, err := rp.RocketStorage.GetString(opts, key)
+    if err != nil {
+        return "", fmt.Errorf("Could not get storage string value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetStrings(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]string, error) {
+    values := make([]string, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetString(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []string{}, err
+    }
+    return values, nil
+}
+
+
+// Get a bytes value
+func GetBytes(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) ([]byte, error) {
+    value, err := rp.RocketStorage.GetBytes(opts, key)
+    if err != nil {
+        return []byte{}, fmt.Errorf("Could not get storage bytes value %s: %w", key.Hex(), err)
+    }
+    return value, nil
+}
+func GetBytesValues(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([][]byte, error) {
+    values := make([][]byte, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetBytes(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return [][]byte{}, err
+    }
+    return values, nil
+}
+
+
+// Get a bytes32 value
+func GetBytes32(rp *rocketpool.RocketPool, key common.Hash, opts *bind.CallOpts) (common.Hash, error) {
+    value, err := rp.RocketStorage.GetBytes32(opts, key)
+    if err != nil {
+        return common.Hash{}, fmt.Errorf("Could not get storage bytes32 value %s: %w", key.Hex(), err)
+    }
+    return common.Hash(value), nil
+}
+func GetBytes32Values(rp *rocketpool.RocketPool, keys []common.Hash, opts *bind.CallOpts) ([]common.Hash, error) {
+    values := make([]common.Hash, len(keys))
+    if err := loadBatched(len(keys), func(ki int) error {
+        value, err := GetBytes32(rp, keys[ki], opts)
+        if err == nil { values[ki] = value }
+        return err
+    }); err != nil {
+        return []common.Hash{}, err
+    }
+    return values, nil
+}
+
+
+// Load values by index in batches
+func loadBatched(count int, load func(index int) error) error {
+    for bsi := 0; bsi < count; bsi += StorageBatchSize {
+
+        // Get batch start & end index
+        ksi := bsi
+        kei := bsi + StorageBatchSize
+        if kei > count { kei = count }
+
+        // Load values
+        var wg errgroup.Group
+        for ki := ksi; ki < kei; ki++ {
+            ki := ki
+            wg.Go(func() error {
+                return load(ki)
+            })
+        }
+        if err := wg.Wait(); err != nil {
+            return err
+        }
+
+    }
+    return nil
+}
+
@@ -0,0 +1,150 @@
+package beacon
+
+import (
+    "fmt"
+    "net/http"
+    "net/http/httptest"
+    "strings"
+    "testing"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Test validator pubkeys
+var (
+    pubkey1 = rptypes.BytesToValidatorPubkey([]byte{1})
+    pubkey2 = rptypes.BytesToValidatorPubkey([]byte{2})
+)
+
+
+func TestHTTPClient(t *testing.T) {
+
+    // Initialize beacon node API server
+    var validatorsPath, validatorIds string
+    server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+        switch r.URL.Path {
+            case "/eth/v1/beacon/genesis":
+                fmt.Fprint(w, `{"data":{"genesis_time":"1600000000","genesis_fork_version":"0x00000000"}}`)
+            case "/eth/v1/config/spec":
+                fmt.Fprint(w, `{"data":{"SECONDS_PER_SLOT":"12","SLOTS_PER_EPOCH":"32"}}`)
+            case "/eth/v1/beacon/headers/head":
+                fmt.Fprint(w, `{"data":{"root":"0x00","canonical":true,"header":{"message":{"slot":"6500","proposer_index":"1"}}}}`)
+            case "/eth/v1/beacon/states/head/finality_checkpoints":
+                fmt.Fprint(w, `{"data":{"previous_justified":{"epoch":"200"},"current_justified":{"epoch":"201"},"finalized":{"epoch":"200"}}}`)
+            case "/eth/v1/beacon/states/3200/validators":
+                validatorsPath = r.URL.Path
+                validatorIds = r.URL.Query().Get("id")
+                fmt.Fprintf(w, `{"data":[{"index":"7","balance":"32500000000","status":"active_exiting","validator":{"pubkey":"0x%s","withdrawal_credentials":"0x00","effective_balance":"32000000000","slashed":false,"activation_eligibility_epoch":"0","activation_epoch":"10","exit_epoch":"120","withdrawable_epoch":"18446744073709551615"}}]}`, pubkey1.Hex())
+            default:
+                w.WriteHeader(http.StatusNotFound)
+                fmt.Fprint(w, `{"code":404,"message":"Not found"}`)
+        }
+    }))
+    defer server.Close()
+    bc := beacon.NewHTTPClient(server.URL + "/")
+
+    // Get & check config
+    if config, err := bc.GetConfig(); err != nil {
+        t.Fatal(err)
+    } else {
+        if !config.GenesisTime.Equal(time.Unix(1600000000, 0)) {
+            t.Errorf("Incorrect genesis time %s", config.GenesisTime.String())
+        }
+        if config.SecondsPerSlot != 12 || config.SlotsPerEpoch != 32 {
+            t.Errorf("Incorrect slot config %d, %d", config.SecondsPerSlot, config.SlotsPerEpoch)
+        }
+        if epoch := config.EpochAt(time.Unix(1600000000 + 12 * 32 * 5 + 10, 0)); epoch != 5 {
+            t.Errorf("Incorrect epoch at time %d", epoch)
+        }
+    }
+
+    // Get & check chain head
+    if head, err := bc.GetChainHead(); err != nil {
+        t.Fatal(err)
+    } else if head.Slot != 6500 || head.Epoch != 203 || head.JustifiedEpoch != 201 || head.FinalizedEpoch != 200 {
+        t.Errorf("Incorrect chain head %+v", head)
+    }
+
+    // Get & check validators
+    if validators, err := bc.GetValidators([]rptypes.ValidatorPubkey{pubkey1, pubkey2}, 100); err != nil {
+        t.Fatal(err)
+    } else {
+        if validatorsPath != "/eth/v1/beacon/states/3200/validators" {
+            t.Errorf("Incorrect validators request path %s", validatorsPath)
+        }
+        if validatorIds != strings.Join([]string{"0x" + pubkey1.Hex(), "0x" + pubkey2.Hex()}, ",") {
+            t.Errorf("Incorrect validators request ids %s", validatorIds)
+        }
+        validator := validators[pubkey1]
+        if !validator.Exists {
+            t.Fatal("Validator 1 does not exist")
+        }
+        if validator.Index != 7 || validator.Status != beacon.ValidatorActiveExiting || validator.ExitEpoch != 120 || validator.WithdrawableEpoch != beacon.FarFutureEpoch {
+            t.Errorf("Incorrect validator 1 details %+v", validator)
+        }
+        if validator.Balance.Cmp(eth.EthToWei(32.5)) != 0 {
+            t.Errorf("Incorrect validator 1 balance %s", validator.Balance.String())
+        }
+        if !validator.Status.IsActive() || validator.Status.IsExited() {
+            t.Errorf("Incorrect validator 1 status checks for %s", validator.Status)
+        }
+        if validators[pubkey2].Exists {
+            t.Error("Validator 2 exists")
+        }
+    }
+
+    // Check request errors
+    if _, err := bc.GetValidator(pubkey1, 1); err == nil || !strings.Contains(err.Error(), "Not found") {
+        t.Errorf("Incorrect validator request error %v", err)
+    }
+
+}
+
+
+func TestFakeClient(t *testing.T) {
+
+    // Initialize fake client
+    bc := beacon.NewFakeClient(beacon.Config{GenesisTime: time.Unix(0, 0), SecondsPerSlot: 12, SlotsPerEpoch: 32})
+    bc.SetChainHead(beacon.ChainHead{Slot: 640, Epoch: 20, FinalizedEpoch: 18})
+    bc.SetValidator(10, beacon.Validator{Pubkey: pubkey1, Status: beacon.ValidatorActiveOngoing, Balance: eth.EthToWei(32)})
+    bc.SetValidator(15, beacon.Validator{Pubkey: pubkey1, Status: beacon.ValidatorExitedUnslashed, Balance: eth.EthToWei(33)})
+
+    // Check chain head
+    if head, err := bc.GetChainHead(); err != nil {
+        t.Error(err)
+    } else if head.Epoch != 20 || head.FinalizedEpoch != 18 {
+        t.Errorf("Incorrect chain head %+v", head)
+    }
+
+    // Check validator states by epoch
+    for _, test := range []struct{
+        epoch uint64
+        exists bool
+        status beacon.ValidatorStatus
+    }{
+        {5, false, ""},
+        {10, true, beacon.ValidatorActiveOngoing},
+        {14, true, beacon.ValidatorActiveOngoing},
+        {15, true, beacon.ValidatorExitedUnslashed},
+        {100, true, beacon.ValidatorExitedUnslashed},
+    } {
+        if validator, err := bc.GetValidator(pubkey1, test.epoch); err != nil {
+            t.Error(err)
+        } else if validator.Exists != test.exists || validator.Status != test.status {
+            t.Errorf("Incorrect validator state at epoch %d: %+v", test.epoch, validator)
+        }
+    }
+
+    // Check unknown validators
+    if validators, err := bc.GetValidators([]rptypes.ValidatorPubkey{pubkey1, pubkey2}, 20); err != nil {
+        t.Error(err)
+    } else if len(validators) != 2 || validators[pubkey2].Exists || validators[pubkey1].Balance.Cmp(eth.EthToWei(33)) != 0 {
+        t.Errorf("Incorrect validators %+v", validators)
+    }
+
+}
+
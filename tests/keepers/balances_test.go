@@ -0,0 +1,155 @@
+package keepers
+
+import (
+    "math/big"
+    "testing"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/keepers"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    nodeutils "github.com/rocket-pool/rocketpool-go/tests/testutils/node"
+)
+
+
+func TestBalanceOracle(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Set submission frequency
+    var submitBalancesFrequency uint64 = 4
+    if _, err := settings.SetSubmitBalancesFrequency(rp, submitBalancesFrequency, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Make user deposits
+    userDepositOpts := userAccount.GetTransactor()
+    userDepositOpts.Value = eth.EthToWei(20)
+    if _, err := deposit.Deposit(rp, userDepositOpts); err != nil { t.Fatal(err) }
+
+    // Stake minipool & mine to next submission block
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    if err := evm.MineBlocks(int(submitBalancesFrequency)); err != nil { t.Fatal(err) }
+
+    // Get minipool details
+    pubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+    if err != nil { t.Fatal(err) }
+    nodeFee, err := mp.GetNodeFee(nil)
+    if err != nil { t.Fatal(err) }
+
+    // Get expected staking balance
+    validatorBalance := eth.EthToWei(33)
+    nodeAmount := minipool.CalculateNodeRewardAmount(nodeFee, eth.EthToWei(16), eth.EthToWei(32), validatorBalance)
+    expectedStakingEth := new(big.Int).Sub(validatorBalance, nodeAmount)
+
+    // Initialize beacon client & balance oracle
+    bc := newBeaconClient()
+    bc.SetValidator(0, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorActiveOngoing, Balance: validatorBalance})
+    oracle := keepers.NewBalanceOracle(rp, trustedNodeAccount.GetTransactor(), bc, keepers.BalanceOracleConfig{})
+
+    // Submit balances
+    var submission keepers.BalanceSubmissionRecord
+    if records, err := oracle.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect balance submission record count %d", len(records))
+    } else if records[0].Error != "" {
+        t.Fatalf("Balance submission failed: %s", records[0].Error)
+    } else {
+        submission = records[0]
+    }
+
+    // Check submitted balances
+    if submission.Balances.Block == 0 || submission.Balances.Block % submitBalancesFrequency != 0 {
+        t.Errorf("Incorrect balance submission block %d", submission.Balances.Block)
+    }
+    if submission.Balances.StakingETH.Cmp(expectedStakingEth) != 0 {
+        t.Errorf("Incorrect staking ETH balance %s", submission.Balances.StakingETH.String())
+    }
+    if submission.Balances.DepositPool.Cmp(eth.EthToWei(4)) != 0 {
+        t.Errorf("Incorrect deposit pool balance %s", submission.Balances.DepositPool.String())
+    }
+    expectedTotalEth := new(big.Int).Add(expectedStakingEth, submission.Balances.DepositPool)
+    expectedTotalEth.Add(expectedTotalEth, submission.Balances.RETHContract)
+    if submission.Balances.TotalETH.Cmp(expectedTotalEth) != 0 {
+        t.Errorf("Incorrect total ETH balance %s", submission.Balances.TotalETH.String())
+    }
+    if submission.Balances.RETHSupply.Cmp(eth.EthToWei(20)) != 0 {
+        t.Errorf("Incorrect rETH supply %s", submission.Balances.RETHSupply.String())
+    }
+
+    // Check network balances
+    if balancesBlock, err := network.GetBalancesBlock(rp, nil); err != nil {
+        t.Error(err)
+    } else if balancesBlock != submission.Balances.Block {
+        t.Errorf("Incorrect network balances block %d", balancesBlock)
+    }
+    if totalEth, err := network.GetTotalETHBalance(rp, nil); err != nil {
+        t.Error(err)
+    } else if totalEth.Cmp(submission.Balances.TotalETH) != 0 {
+        t.Errorf("Incorrect network total ETH balance %s", totalEth.String())
+    }
+
+    // Check balances are not resubmitted
+    if records, err := oracle.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect balance submission record count %d after submission", len(records))
+    }
+
+}
+
+
+func TestBalanceOracleDisabled(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register trusted node
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Disable balance submissions
+    if _, err := settings.SetSubmitBalancesEnabled(rp, false, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check submission fails
+    oracle := keepers.NewBalanceOracle(rp, trustedNodeAccount.GetTransactor(), newBeaconClient(), keepers.BalanceOracleConfig{})
+    if _, err := oracle.Run(); err != keepers.ErrSubmitBalancesDisabled {
+        t.Errorf("Incorrect balance oracle error %v while submissions are disabled", err)
+    }
+
+}
+
+
+func TestBalanceOracleUntrusted(t *testing.T) {
+
+    // Check submission fails from an untrusted account
+    oracle := keepers.NewBalanceOracle(rp, nodeAccount.GetTransactor(), newBeaconClient(), keepers.BalanceOracleConfig{})
+    if _, err := oracle.Run(); err != keepers.ErrNotTrustedNode {
+        t.Errorf("Incorrect balance oracle error %v from an untrusted account", err)
+    }
+
+}
+
+
+// Create a fake beacon client with genesis at the unix epoch
+func newBeaconClient() *beacon.FakeClient {
+    return beacon.NewFakeClient(beacon.Config{GenesisTime: time.Unix(0, 0), SecondsPerSlot: 12, SlotsPerEpoch: 32})
+}
+
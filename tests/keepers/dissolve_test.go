@@ -0,0 +1,71 @@
+package keepers
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/keepers"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestDissolver(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Set launch timeout
+    if _, err := settings.SetMinipoolLaunchTimeout(rp, 10, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create prelaunch minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+
+    // Initialize dissolver
+    dissolver := keepers.NewDissolver(rp, userAccount.GetTransactor(), keepers.DissolverConfig{
+        MaxGasSpend: eth.EthToWei(1),
+    })
+
+    // Check minipool has not timed out
+    if records, err := dissolver.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect dissolve record count %d before launch timeout", len(records))
+    }
+
+    // Mine blocks past launch timeout
+    if err := evm.MineBlocks(10); err != nil { t.Fatal(err) }
+
+    // Dissolve timed out minipool
+    if records, err := dissolver.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect dissolve record count %d after launch timeout", len(records))
+    } else if records[0].Error != "" {
+        t.Errorf("Could not dissolve minipool: %s", records[0].Error)
+    } else if !bytes.Equal(records[0].Minipool.Bytes(), mp.Address.Bytes()) {
+        t.Errorf("Incorrect dissolved minipool %s", records[0].Minipool.Hex())
+    }
+
+    // Check minipool status & records
+    if status, err := mp.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Dissolved {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+    if records := dissolver.GetRecords(); len(records) != 1 {
+        t.Errorf("Incorrect dissolver record count %d", len(records))
+    }
+
+}
+
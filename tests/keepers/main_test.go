@@ -0,0 +1,54 @@
+package keepers
+
+import (
+    "log"
+    "os"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/ethclient"
+
+    "github.com/rocket-pool/rocketpool-go/rocketpool"
+
+    "github.com/rocket-pool/rocketpool-go/tests"
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/accounts"
+)
+
+
+var (
+    client *ethclient.Client
+    rp *rocketpool.RocketPool
+
+    ownerAccount *accounts.Account
+    trustedNodeAccount *accounts.Account
+    nodeAccount *accounts.Account
+    userAccount *accounts.Account
+)
+
+
+func TestMain(m *testing.M) {
+    var err error
+
+    // Initialize eth client
+    client, err = ethclient.Dial(tests.Eth1ProviderAddress)
+    if err != nil { log.Fatal(err) }
+
+    // Initialize contract manager
+    rp, err = rocketpool.NewRocketPool(client, common.HexToAddress(tests.RocketStorageAddress))
+    if err != nil { log.Fatal(err) }
+
+    // Initialize accounts
+    ownerAccount, err = accounts.GetAccount(0)
+    if err != nil { log.Fatal(err) }
+    trustedNodeAccount, err = accounts.GetAccount(1)
+    if err != nil { log.Fatal(err) }
+    nodeAccount, err = accounts.GetAccount(2)
+    if err != nil { log.Fatal(err) }
+    userAccount, err = accounts.GetAccount(9)
+    if err != nil { log.Fatal(err) }
+
+    // Run tests
+    os.Exit(m.Run())
+
+}
+
@@ -0,0 +1,209 @@
+package keepers
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/beacon"
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/keepers"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    nodeutils "github.com/rocket-pool/rocketpool-go/tests/testutils/node"
+)
+
+
+func TestWithdrawableWatchtower(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Make user deposit
+    userDepositOpts := userAccount.GetTransactor()
+    userDepositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, userDepositOpts); err != nil { t.Fatal(err) }
+
+    // Stake minipool
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    pubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Initialize beacon client & watchtower
+    bc := newBeaconClient()
+    bc.SetChainHead(beacon.ChainHead{Slot: 640, Epoch: 20, JustifiedEpoch: 19, FinalizedEpoch: 18})
+    bc.SetValidator(2, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorActiveOngoing, Balance: eth.EthToWei(31), ActivationEpoch: 2})
+    watchtower := keepers.NewWithdrawableWatchtower(rp, trustedNodeAccount.GetTransactor(), bc, keepers.WithdrawableWatchtowerConfig{})
+
+    // Check active minipool is not submitted
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect withdrawable record count %d while validator is active", len(records))
+    }
+
+    // Check minipool exited after the finalized epoch is not submitted
+    bc.SetValidator(19, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorExitedUnslashed, Balance: eth.EthToWei(33), ActivationEpoch: 2})
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect withdrawable record count %d before validator exit is finalized", len(records))
+    }
+
+    // Submit withdrawable event
+    bc.SetValidator(15, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorExitedUnslashed, Balance: eth.EthToWei(33), ActivationEpoch: 2})
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect withdrawable record count %d after validator exit", len(records))
+    } else if records[0].Error != "" {
+        t.Fatalf("Withdrawable submission failed: %s", records[0].Error)
+    } else if records[0].Minipool != mp.Address || !records[0].Submitted {
+        t.Errorf("Incorrect withdrawable record %+v", records[0])
+    }
+
+    // Check minipool status & staking balances
+    if status, err := mp.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Withdrawable {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+    if stakingDetails, err := mp.GetStakingDetails(nil); err != nil {
+        t.Error(err)
+    } else {
+        if stakingDetails.StartBalance.Cmp(eth.EthToWei(31)) != 0 {
+            t.Errorf("Incorrect minipool staking start balance %s", stakingDetails.StartBalance.String())
+        }
+        if stakingDetails.EndBalance.Cmp(eth.EthToWei(33)) != 0 {
+            t.Errorf("Incorrect minipool staking end balance %s", stakingDetails.EndBalance.String())
+        }
+    }
+
+    // Check withdrawable minipool is not resubmitted
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect withdrawable record count %d after submission", len(records))
+    }
+
+}
+
+
+func TestWithdrawableWatchtowerWithdrawalPossible(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create & stake minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    pubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Initialize beacon client & watchtower with a validator awaiting withdrawal
+    bc := newBeaconClient()
+    bc.SetChainHead(beacon.ChainHead{Slot: 640, Epoch: 20, JustifiedEpoch: 19, FinalizedEpoch: 18})
+    bc.SetValidator(0, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorActiveOngoing, Balance: eth.EthToWei(32)})
+    bc.SetValidator(15, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorWithdrawalPossible, Balance: eth.EthToWei(34)})
+    watchtower := keepers.NewWithdrawableWatchtower(rp, trustedNodeAccount.GetTransactor(), bc, keepers.WithdrawableWatchtowerConfig{})
+
+    // Submit withdrawable event
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect withdrawable record count %d while validator withdrawal is possible", len(records))
+    } else if records[0].Error != "" {
+        t.Fatalf("Withdrawable submission failed: %s", records[0].Error)
+    } else if records[0].Minipool != mp.Address || records[0].Status != beacon.ValidatorWithdrawalPossible || !records[0].Submitted {
+        t.Errorf("Incorrect withdrawable record %+v", records[0])
+    }
+
+    // Check minipool status & staking end balance
+    if status, err := mp.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Withdrawable {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+    if stakingDetails, err := mp.GetStakingDetails(nil); err != nil {
+        t.Error(err)
+    } else if stakingDetails.EndBalance.Cmp(eth.EthToWei(34)) != 0 {
+        t.Errorf("Incorrect minipool staking end balance %s", stakingDetails.EndBalance.String())
+    }
+
+}
+
+
+func TestWithdrawableWatchtowerWithdrawalDone(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create & stake minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    pubkey, err := minipool.GetMinipoolPubkey(rp, mp.Address, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Initialize beacon client & watchtower with a withdrawn validator
+    bc := newBeaconClient()
+    bc.SetChainHead(beacon.ChainHead{Slot: 640, Epoch: 20, JustifiedEpoch: 19, FinalizedEpoch: 18})
+    bc.SetValidator(0, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorActiveOngoing, Balance: eth.EthToWei(32)})
+    bc.SetValidator(15, beacon.Validator{Pubkey: pubkey, Status: beacon.ValidatorWithdrawalDone, Balance: big.NewInt(0)})
+    watchtower := keepers.NewWithdrawableWatchtower(rp, trustedNodeAccount.GetTransactor(), bc, keepers.WithdrawableWatchtowerConfig{})
+
+    // Check withdrawn minipool is not submitted
+    if records, err := watchtower.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect withdrawable record count %d after validator withdrawal", len(records))
+    }
+
+}
+
+
+func TestWithdrawableWatchtowerDisabled(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register trusted node
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Disable withdrawable submissions
+    if _, err := settings.SetMinipoolSubmitWithdrawableEnabled(rp, false, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check scan fails
+    watchtower := keepers.NewWithdrawableWatchtower(rp, trustedNodeAccount.GetTransactor(), newBeaconClient(), keepers.WithdrawableWatchtowerConfig{})
+    if _, err := watchtower.Run(); err != keepers.ErrSubmitWithdrawableDisabled {
+        t.Errorf("Incorrect withdrawable watchtower error %v while submissions are disabled", err)
+    }
+
+}
+
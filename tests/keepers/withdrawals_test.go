@@ -0,0 +1,119 @@
+package keepers
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/keepers"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    nodeutils "github.com/rocket-pool/rocketpool-go/tests/testutils/node"
+)
+
+
+func TestWithdrawalProcessor(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Make user deposit
+    userDepositOpts := userAccount.GetTransactor()
+    userDepositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, userDepositOpts); err != nil { t.Fatal(err) }
+
+    // Stake minipool & mark as withdrawable
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    if _, err := minipool.SubmitMinipoolWithdrawable(rp, mp.Address, eth.EthToWei(32), eth.EthToWei(32), trustedNodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Initialize withdrawal processor
+    processor := keepers.NewWithdrawalProcessor(rp, trustedNodeAccount.GetTransactor(), keepers.WithdrawalProcessorConfig{
+        MaxGasSpend: eth.EthToWei(1),
+    })
+
+    // Check unprocessed minipools
+    if unprocessed, err := processor.GetUnprocessedMinipools(nil); err != nil {
+        t.Fatal(err)
+    } else if len(unprocessed) != 1 {
+        t.Fatalf("Incorrect unprocessed minipool count %d", len(unprocessed))
+    } else if !bytes.Equal(unprocessed[0].Address.Bytes(), mp.Address.Bytes()) {
+        t.Errorf("Incorrect unprocessed minipool %s", unprocessed[0].Address.Hex())
+    }
+
+    // Check withdrawal is not processed before validator balance is transferred
+    if records, err := processor.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect withdrawal record count %d before balance transfer", len(records))
+    } else if records[0].Outcome != keepers.WithdrawalInsufficientBalance {
+        t.Errorf("Incorrect withdrawal outcome %s before balance transfer", records[0].Outcome)
+    }
+
+    // Transfer validator balance
+    transferWithdrawalOpts := userAccount.GetTransactor()
+    transferWithdrawalOpts.Value = eth.EthToWei(32)
+    if _, err := network.TransferWithdrawal(rp, transferWithdrawalOpts); err != nil { t.Fatal(err) }
+
+    // Process withdrawal
+    if records, err := processor.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 1 {
+        t.Fatalf("Incorrect withdrawal record count %d after balance transfer", len(records))
+    } else if records[0].Outcome != keepers.WithdrawalProcessed {
+        t.Errorf("Incorrect withdrawal outcome %s after balance transfer: %s", records[0].Outcome, records[0].Error)
+    } else if records[0].GasUsed == 0 {
+        t.Error("Incorrect withdrawal gas used 0")
+    }
+
+    // Check minipool withdrawal status & records
+    if processed, err := minipool.GetMinipoolWithdrawalProcessed(rp, mp.Address, nil); err != nil {
+        t.Error(err)
+    } else if !processed {
+        t.Error("Minipool withdrawal was not processed")
+    }
+    if records := processor.GetRecords(); len(records) != 2 {
+        t.Errorf("Incorrect total withdrawal record count %d", len(records))
+    }
+
+    // Check no further withdrawals are processed
+    if records, err := processor.Run(); err != nil {
+        t.Fatal(err)
+    } else if len(records) != 0 {
+        t.Errorf("Incorrect withdrawal record count %d after processing", len(records))
+    }
+
+}
+
+
+func TestWithdrawalProcessorDisabled(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Disable withdrawal processing
+    if _, err := settings.SetProcessWithdrawalsEnabled(rp, false, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check scan fails
+    processor := keepers.NewWithdrawalProcessor(rp, trustedNodeAccount.GetTransactor(), keepers.WithdrawalProcessorConfig{})
+    if _, err := processor.Run(); err != keepers.ErrProcessWithdrawalsDisabled {
+        t.Errorf("Incorrect withdrawal processor error %v while processing is disabled", err)
+    }
+
+}
+
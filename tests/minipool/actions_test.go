@@ -0,0 +1,103 @@
+package minipool
+
+import (
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestActionEligibility(t *testing.T) {
+
+    // Prelaunch minipool, before launch timeout
+    actions := minipool.GetActionEligibility(minipool.LifecycleState{
+        Status: rptypes.Prelaunch,
+        DepositType: rptypes.Full,
+        StatusBlock: 100,
+        RefundBalance: eth.EthToWei(16),
+        CurrentBlock: 110,
+        LaunchTimeout: 50,
+        WithdrawalDelay: 20,
+        CallerIsOwner: false,
+    })
+    if actions[minipool.RefundAction].Valid {
+        t.Error("Refund should not be valid for a non-owner")
+    }
+    if actions[minipool.DissolveAction].Valid {
+        t.Error("Dissolve should not be valid before the launch timeout")
+    } else if actions[minipool.DissolveAction].AvailableBlock != 150 {
+        t.Errorf("Incorrect dissolve available block %d", actions[minipool.DissolveAction].AvailableBlock)
+    }
+
+    // Prelaunch minipool, after launch timeout
+    actions = minipool.GetActionEligibility(minipool.LifecycleState{
+        Status: rptypes.Prelaunch,
+        DepositType: rptypes.Full,
+        StatusBlock: 100,
+        RefundBalance: eth.EthToWei(16),
+        CurrentBlock: 150,
+        LaunchTimeout: 50,
+        WithdrawalDelay: 20,
+        CallerIsOwner: false,
+    })
+    if !actions[minipool.DissolveAction].Valid {
+        t.Errorf("Dissolve should be valid after the launch timeout: %s", actions[minipool.DissolveAction].Reason)
+    }
+
+    // Withdrawable minipool, owner
+    actions = minipool.GetActionEligibility(minipool.LifecycleState{
+        Status: rptypes.Withdrawable,
+        DepositType: rptypes.Half,
+        StatusBlock: 100,
+        RefundBalance: eth.EthToWei(0),
+        CurrentBlock: 110,
+        LaunchTimeout: 50,
+        WithdrawalDelay: 20,
+        CallerIsOwner: true,
+    })
+    for _, action := range actions {
+        if action.Valid {
+            t.Errorf("Action %s should not be valid: %s", action.Action.String(), action.Reason)
+        }
+    }
+    if actions[minipool.WithdrawAction].AvailableBlock != 120 {
+        t.Errorf("Incorrect withdraw available block %d", actions[minipool.WithdrawAction].AvailableBlock)
+    }
+
+}
+
+
+func TestMinipoolActionEligibility(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+
+    // Get & check action eligibility
+    actions, err := mp.GetActionEligibility(nodeAccount.Address, nil)
+    if err != nil { t.Fatal(err) }
+    if !actions[minipool.StakeAction].Valid {
+        t.Errorf("Stake should be valid for a prelaunch minipool: %s", actions[minipool.StakeAction].Reason)
+    }
+    if !actions[minipool.DissolveAction].Valid {
+        t.Errorf("Dissolve should be valid for the minipool owner: %s", actions[minipool.DissolveAction].Reason)
+    }
+    if actions[minipool.CloseAction].Valid {
+        t.Error("Close should not be valid for a prelaunch minipool")
+    }
+
+}
+
@@ -4,6 +4,8 @@ import (
     "bytes"
     "testing"
 
+    "github.com/ethereum/go-ethereum/common"
+
     "github.com/rocket-pool/rocketpool-go/deposit"
     "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/network"
@@ -185,6 +187,81 @@ func TestStake(t *testing.T) {
 }
 
 
+func TestStakeInvalidDepositData(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+
+    // Get validator & deposit data with incorrect withdrawal credentials
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { t.Fatal(err) }
+    depositDataRoot, err := validator.GetDepositDataRoot(validatorPubkey, common.HexToHash("0x01"), validatorSignature)
+    if err != nil { t.Fatal(err) }
+
+    // Attempt to stake minipool
+    if _, err := mp.Stake(validatorPubkey, validatorSignature, depositDataRoot, nodeAccount.GetTransactor()); err == nil {
+        t.Error("Staked minipool with invalid deposit data")
+    }
+
+    // Get & check minipool status
+    if status, err := mp.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Prelaunch {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+
+}
+
+
+func TestStakeInvalidDepositSignature(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipool & set incorrect deposit fork version
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    mp.DepositForkVersion = &rptypes.ForkVersion{0x01, 0x00, 0x00, 0x00}
+
+    // Get validator & deposit data
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { t.Fatal(err) }
+    withdrawalCredentials, err := network.GetWithdrawalCredentials(rp, nil)
+    if err != nil { t.Fatal(err) }
+    depositDataRoot, err := validator.GetDepositDataRoot(validatorPubkey, withdrawalCredentials, validatorSignature)
+    if err != nil { t.Fatal(err) }
+
+    // Attempt to stake minipool
+    if _, err := mp.Stake(validatorPubkey, validatorSignature, depositDataRoot, nodeAccount.GetTransactor()); err == nil {
+        t.Error("Staked minipool with invalid deposit signature")
+    }
+
+    // Get & check minipool status
+    if status, err := mp.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Prelaunch {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+
+}
+
+
 func TestWithdraw(t *testing.T) {
 
     // State snapshotting
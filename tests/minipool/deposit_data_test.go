@@ -0,0 +1,119 @@
+package minipool
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/validator"
+)
+
+
+func TestMatchDepositData(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    mp1, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    if _, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32)); err != nil { t.Fatal(err) }
+
+    // Get deposit data file entries
+    withdrawalCredentials, err := network.GetWithdrawalCredentials(rp, nil)
+    if err != nil { t.Fatal(err) }
+    validEntry, err := getDepositDataFileEntry(withdrawalCredentials)
+    if err != nil { t.Fatal(err) }
+    invalidEntry, err := getDepositDataFileEntry(common.HexToHash("0x01"))
+    if err != nil { t.Fatal(err) }
+    entries := []rptypes.DepositDataFileEntry{invalidEntry, validEntry, validEntry}
+
+    // Match deposit data & check matches
+    matches, err := minipool.MatchDepositData(rp, nodeAccount.Address, entries, nil)
+    if err != nil { t.Fatal(err) }
+    if len(matches) != 1 {
+        t.Fatalf("Incorrect deposit data match count %d", len(matches))
+    }
+    if !bytes.Equal(matches[0].Minipool.Bytes(), mp1.Address.Bytes()) {
+        t.Errorf("Incorrect matched minipool %s", matches[0].Minipool.Hex())
+    }
+    if matches[0].Entry.PublicKey != validEntry.PublicKey {
+        t.Errorf("Incorrect matched deposit data pubkey %s", matches[0].Entry.PublicKey.Hex())
+    }
+
+    // Check entries for other fork versions are rejected
+    otherForkEntry := matches[0].Entry
+    otherForkEntry.ForkVersion = rptypes.ForkVersion{0xff}
+    if _, err := mp1.StakeWithDepositData(otherForkEntry, nodeAccount.GetTransactor()); err == nil {
+        t.Error("Staked minipool with a deposit data entry signed for a different fork version")
+    }
+    mp1.DepositForkVersion = &otherForkEntry.ForkVersion
+    if _, err := mp1.StakeWithDepositData(matches[0].Entry, nodeAccount.GetTransactor()); err == nil {
+        t.Error("Staked minipool with a deposit data entry for a fork version other than the minipool's")
+    }
+    mp1.DepositForkVersion = nil
+
+    // Stake minipool with matched entry
+    if _, err := mp1.StakeWithDepositData(matches[0].Entry, nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if status, err := mp1.GetStatus(nil); err != nil {
+        t.Error(err)
+    } else if status != rptypes.Staking {
+        t.Errorf("Incorrect minipool status %s", status.String())
+    }
+
+    // Check in-use validator pubkeys are not matched
+    if matches, err := minipool.MatchDepositData(rp, nodeAccount.Address, entries, nil); err != nil {
+        t.Error(err)
+    } else if len(matches) != 0 {
+        t.Errorf("Incorrect deposit data match count %d", len(matches))
+    }
+
+}
+
+
+// Get a deposit data file entry for the test validator
+func getDepositDataFileEntry(withdrawalCredentials common.Hash) (rptypes.DepositDataFileEntry, error) {
+
+    // Get validator data
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { return rptypes.DepositDataFileEntry{}, err }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { return rptypes.DepositDataFileEntry{}, err }
+    forkVersion, err := validator.GetDepositForkVersion()
+    if err != nil { return rptypes.DepositDataFileEntry{}, err }
+
+    // Get deposit data & roots
+    depositData := rptypes.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: withdrawalCredentials,
+        Amount: rptypes.DepositAmount,
+        Signature: validatorSignature,
+    }
+    depositMessageRoot, err := depositData.MessageRoot()
+    if err != nil { return rptypes.DepositDataFileEntry{}, err }
+    depositDataRoot, err := depositData.HashTreeRoot()
+    if err != nil { return rptypes.DepositDataFileEntry{}, err }
+
+    // Return
+    return rptypes.DepositDataFileEntry{
+        DepositData: depositData,
+        DepositMessageRoot: depositMessageRoot,
+        DepositDataRoot: depositDataRoot,
+        ForkVersion: forkVersion,
+    }, nil
+
+}
+
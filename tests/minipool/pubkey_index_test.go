@@ -0,0 +1,105 @@
+package minipool
+
+import (
+    "bytes"
+    "io/ioutil"
+    "os"
+    "path/filepath"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/validator"
+)
+
+
+func TestPubkeyIndex(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    mp1, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    mp2, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+
+    // Create & update index
+    index := minipool.NewPubkeyIndex()
+    if err := index.Update(rp, nil); err != nil { t.Fatal(err) }
+    if index.GetMinipoolCount() < 2 {
+        t.Errorf("Incorrect pubkey index minipool count %d", index.GetMinipoolCount())
+    }
+    if entry, ok := index.GetMinipool(mp2.Address); !ok || entry.Staked || !bytes.Equal(entry.Node.Bytes(), nodeAccount.Address.Bytes()) {
+        t.Errorf("Incorrect pubkey index entry for unstaked minipool %v", entry)
+    }
+
+    // Get validator pubkeys
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    unknownPubkey := rptypes.BytesToValidatorPubkey([]byte{0x01})
+
+    // Check pubkey is not resolved before staking
+    if resolutions := index.Resolve([]rptypes.ValidatorPubkey{validatorPubkey}); resolutions[0].Found {
+        t.Error("Resolved validator pubkey before minipool was staked")
+    }
+
+    // Stake minipool & update index
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+    if err := minipoolutils.StakeMinipool(rp, mp1, nodeAccount); err != nil { t.Fatal(err) }
+    lastBlock := index.GetLastBlock()
+    if err := index.Update(rp, nil); err != nil { t.Fatal(err) }
+    if index.GetLastBlock() <= lastBlock {
+        t.Errorf("Incorrect pubkey index last block %d", index.GetLastBlock())
+    }
+
+    // Resolve pubkeys from index
+    resolutions := index.Resolve([]rptypes.ValidatorPubkey{validatorPubkey, unknownPubkey})
+    if !resolutions[0].Found || !bytes.Equal(resolutions[0].Minipool.Bytes(), mp1.Address.Bytes()) || !bytes.Equal(resolutions[0].Node.Bytes(), nodeAccount.Address.Bytes()) {
+        t.Errorf("Incorrect validator pubkey resolution %v", resolutions[0])
+    }
+    if resolutions[1].Found {
+        t.Error("Resolved unknown validator pubkey")
+    }
+
+    // Save & load index
+    dir, err := ioutil.TempDir("", "pubkey-index")
+    if err != nil { t.Fatal(err) }
+    t.Cleanup(func() { os.RemoveAll(dir) })
+    indexPath := filepath.Join(dir, "index.json")
+    if err := index.Save(indexPath); err != nil { t.Fatal(err) }
+    loadedIndex, err := minipool.LoadPubkeyIndex(indexPath)
+    if err != nil { t.Fatal(err) }
+    if loadedIndex.GetLastBlock() != index.GetLastBlock() || loadedIndex.GetMinipoolCount() != index.GetMinipoolCount() {
+        t.Errorf("Incorrect loaded pubkey index %d %d", loadedIndex.GetLastBlock(), loadedIndex.GetMinipoolCount())
+    }
+    if resolutions := loadedIndex.Resolve([]rptypes.ValidatorPubkey{validatorPubkey}); !resolutions[0].Found || !bytes.Equal(resolutions[0].Minipool.Bytes(), mp1.Address.Bytes()) {
+        t.Errorf("Incorrect loaded validator pubkey resolution %v", resolutions[0])
+    }
+
+    // Resolve pubkeys with network fallback
+    if resolutions, err := minipool.NewPubkeyIndex().ResolveWithFallback(rp, []rptypes.ValidatorPubkey{validatorPubkey, unknownPubkey}, nil); err != nil {
+        t.Error(err)
+    } else {
+        if !resolutions[0].Found || !bytes.Equal(resolutions[0].Minipool.Bytes(), mp1.Address.Bytes()) || !bytes.Equal(resolutions[0].Node.Bytes(), nodeAccount.Address.Bytes()) {
+            t.Errorf("Incorrect validator pubkey fallback resolution %v", resolutions[0])
+        }
+        if resolutions[1].Found {
+            t.Error("Resolved unknown validator pubkey with fallback")
+        }
+    }
+
+}
+
@@ -0,0 +1,95 @@
+package minipool
+
+import (
+    "bytes"
+    "testing"
+    "time"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestQueryFilter(t *testing.T) {
+
+    // Cached minipool snapshots
+    node1 := common.HexToAddress("0x1111111111111111111111111111111111111111")
+    node2 := common.HexToAddress("0x2222222222222222222222222222222222222222")
+    snapshots := make([]minipool.MinipoolSnapshot, 4)
+    for si := range snapshots {
+        snapshots[si].Address = common.BigToAddress(eth.EthToWei(float64(si + 1)))
+        snapshots[si].Exists = true
+        snapshots[si].Status.StatusTime = time.Unix(int64(1000 * (si + 1)), 0)
+        snapshots[si].Balance = eth.EthToWei(float64(16 * (si + 1)))
+        snapshots[si].DepositType = rptypes.Full
+    }
+    snapshots[0].Status.Status = rptypes.Prelaunch
+    snapshots[0].Node.Address = node1
+    snapshots[1].Status.Status = rptypes.Staking
+    snapshots[1].Node.Address = node1
+    snapshots[2].Status.Status = rptypes.Staking
+    snapshots[2].Node.Address = node2
+    snapshots[3].Status.Status = rptypes.Withdrawable
+    snapshots[3].Node.Address = node1
+    snapshots[3].Withdrawable = true
+
+    // Filter by status & node
+    if results := minipool.NewQuery().Status(rptypes.Staking, rptypes.Withdrawable).Node(node1).Filter(snapshots); len(results) != 2 {
+        t.Errorf("Incorrect status & node query result count %d", len(results))
+    }
+
+    // Filter by withdrawable status
+    if results := minipool.NewQuery().Withdrawable(true).Filter(snapshots); len(results) != 1 || !results[0].Withdrawable {
+        t.Error("Incorrect withdrawable query results")
+    }
+
+    // Filter by status time & balance
+    if results := minipool.NewQuery().StatusTimeBetween(time.Unix(2000, 0), time.Time{}).BalanceBetween(nil, eth.EthToWei(48)).Filter(snapshots); len(results) != 2 {
+        t.Errorf("Incorrect status time & balance query result count %d", len(results))
+    }
+
+    // Sort & paginate
+    results := minipool.NewQuery().SortBy(minipool.SortByBalance, true).Page(1, 2).Filter(snapshots)
+    if len(results) != 2 {
+        t.Fatalf("Incorrect paginated query result count %d", len(results))
+    }
+    if !bytes.Equal(results[0].Address.Bytes(), snapshots[2].Address.Bytes()) || !bytes.Equal(results[1].Address.Bytes(), snapshots[1].Address.Bytes()) {
+        t.Error("Incorrect sorted query result order")
+    }
+
+}
+
+
+func TestQueryRun(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    if _, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16)); err != nil { t.Fatal(err) }
+
+    // Run query & check results
+    results, err := minipool.NewQuery().Node(nodeAccount.Address).DepositType(rptypes.Full).Run(rp, nil)
+    if err != nil {
+        t.Fatal(err)
+    } else if len(results) != 1 {
+        t.Fatalf("Incorrect query result count %d", len(results))
+    } else if !bytes.Equal(results[0].Address.Bytes(), mp.Address.Bytes()) {
+        t.Errorf("Incorrect query result minipool %s", results[0].Address.Hex())
+    }
+
+}
+
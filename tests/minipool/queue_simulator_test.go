@@ -0,0 +1,133 @@
+package minipool
+
+import (
+    "bytes"
+    "math/big"
+    "testing"
+    "time"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    nodeutils "github.com/rocket-pool/rocketpool-go/tests/testutils/node"
+)
+
+
+func TestQueueSimulation(t *testing.T) {
+
+    // Create simulation
+    startTime := time.Unix(1600000000, 0)
+    simulation := minipool.QueueSimulation{
+        Items: []minipool.QueueItem{
+            {Minipool: common.HexToAddress("0x01"), DepositType: rptypes.Full},
+            {Minipool: common.HexToAddress("0x02"), DepositType: rptypes.Empty},
+            {Minipool: common.HexToAddress("0x03"), DepositType: rptypes.Half},
+            {Minipool: common.HexToAddress("0x04"), DepositType: rptypes.Full},
+            {Minipool: common.HexToAddress("0x05"), DepositType: rptypes.Half},
+        },
+        Capacities: map[rptypes.MinipoolDeposit]*big.Int{
+            rptypes.Full: eth.EthToWei(16),
+            rptypes.Half: eth.EthToWei(16),
+            rptypes.Empty: eth.EthToWei(32),
+        },
+        DepositPoolBalance: eth.EthToWei(40),
+        MaxAssignments: 2,
+        DepositInflow: eth.EthToWei(10),
+        DepositInterval: time.Hour,
+        StartTime: startTime,
+    }
+
+    // Run simulation
+    // Deposit 1: balance 50, assign 0x03 & 0x05 (half), balance 18
+    // Deposit 2: balance 28, assign 0x01 (full), balance 12
+    // Deposit 3: balance 22, assign 0x04 (full), balance 6
+    // Deposit 6: balance 36, assign 0x02 (empty), balance 4
+    etas, err := simulation.Run()
+    if err != nil { t.Fatal(err) }
+    expected := []struct{
+        minipool common.Address
+        queuePosition uint64
+        deposits uint64
+    }{
+        {common.HexToAddress("0x03"), 0, 1},
+        {common.HexToAddress("0x05"), 1, 1},
+        {common.HexToAddress("0x01"), 0, 2},
+        {common.HexToAddress("0x04"), 1, 3},
+        {common.HexToAddress("0x02"), 0, 6},
+    }
+    if len(etas) != len(expected) {
+        t.Fatalf("Incorrect queue ETA count %d", len(etas))
+    }
+    for ei, eta := range etas {
+        if !bytes.Equal(eta.Minipool.Bytes(), expected[ei].minipool.Bytes()) {
+            t.Errorf("Incorrect queue ETA %d minipool %s", ei, eta.Minipool.Hex())
+        }
+        if eta.AssignmentPosition != uint64(ei) {
+            t.Errorf("Incorrect queue ETA %d assignment position %d", ei, eta.AssignmentPosition)
+        }
+        if eta.QueuePosition != expected[ei].queuePosition {
+            t.Errorf("Incorrect queue ETA %d queue position %d", ei, eta.QueuePosition)
+        }
+        if !eta.Assigned || eta.Deposits != expected[ei].deposits {
+            t.Errorf("Incorrect queue ETA %d deposits %d", ei, eta.Deposits)
+        }
+        if !eta.Time.Equal(startTime.Add(time.Duration(expected[ei].deposits) * time.Hour)) {
+            t.Errorf("Incorrect queue ETA %d time %s", ei, eta.Time.String())
+        }
+    }
+
+    // Run simulation without deposit inflow
+    simulation.DepositInflow = nil
+    if etas, err := simulation.Run(); err != nil {
+        t.Error(err)
+    } else {
+        for ei, eta := range etas {
+            if eta.Assigned { t.Errorf("Queue ETA %d was assigned without deposit inflow", ei) }
+        }
+    }
+
+}
+
+
+func TestGetNodeQueueETAs(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    fullMinipool, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    halfMinipool, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Get & check node queue ETAs
+    etas, err := minipool.GetNodeQueueETAs(rp, nodeAccount.Address, eth.EthToWei(16), time.Hour, nil)
+    if err != nil { t.Fatal(err) }
+    if len(etas) != 2 {
+        t.Fatalf("Incorrect node queue ETA count %d", len(etas))
+    }
+    if !bytes.Equal(etas[0].Minipool.Bytes(), halfMinipool.Address.Bytes()) || etas[0].DepositType != rptypes.Half {
+        t.Errorf("Incorrect first node queue ETA minipool %s", etas[0].Minipool.Hex())
+    }
+    if !bytes.Equal(etas[1].Minipool.Bytes(), fullMinipool.Address.Bytes()) || etas[1].DepositType != rptypes.Full {
+        t.Errorf("Incorrect second node queue ETA minipool %s", etas[1].Minipool.Hex())
+    }
+    for ei, eta := range etas {
+        if !eta.Assigned || eta.Time.IsZero() {
+            t.Errorf("Node queue ETA %d was not assigned", ei)
+        }
+    }
+
+}
+
@@ -1,10 +1,13 @@
 package minipool
 
 import (
+    "bytes"
     "testing"
 
+    "github.com/rocket-pool/rocketpool-go/deposit"
     "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
     "github.com/rocket-pool/rocketpool-go/utils/eth"
 
     "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
@@ -188,3 +191,96 @@ func TestQueueCapacity(t *testing.T) {
 
 }
 
+
+func TestQueueContents(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    fullMinipool1, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    halfMinipool, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+    fullMinipool2, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    emptyMinipool, err := minipoolutils.CreateMinipool(rp, trustedNodeAccount, eth.EthToWei(0))
+    if err != nil { t.Fatal(err) }
+
+    // Get & check queue minipool addresses
+    if addresses, err := minipool.GetQueueMinipoolAddresses(rp, rptypes.Full, nil); err != nil {
+        t.Error(err)
+    } else if len(addresses) != 2 || !bytes.Equal(addresses[0].Bytes(), fullMinipool1.Address.Bytes()) || !bytes.Equal(addresses[1].Bytes(), fullMinipool2.Address.Bytes()) {
+        t.Errorf("Incorrect full deposit queue minipool addresses %v", addresses)
+    }
+
+    // Get & check queue items
+    expected := []*minipool.Minipool{halfMinipool, fullMinipool1, fullMinipool2, emptyMinipool}
+    if items, err := minipool.GetQueueItems(rp, nil); err != nil {
+        t.Error(err)
+    } else if len(items) != len(expected) {
+        t.Errorf("Incorrect queue item count %d", len(items))
+    } else {
+        for ii, item := range items {
+            if !bytes.Equal(item.Minipool.Bytes(), expected[ii].Address.Bytes()) {
+                t.Errorf("Incorrect queue item %d minipool %s", ii, item.Minipool.Hex())
+            }
+        }
+        if !bytes.Equal(items[3].Node.Bytes(), trustedNodeAccount.Address.Bytes()) {
+            t.Errorf("Incorrect queue item node %s", items[3].Node.Hex())
+        }
+    }
+
+    // Get & check minipool queue position
+    if position, err := minipool.GetMinipoolQueuePosition(rp, fullMinipool2.Address, nil); err != nil {
+        t.Error(err)
+    } else {
+        if !position.Queued || position.DepositType != rptypes.Full {
+            t.Errorf("Incorrect minipool queue status %t %s", position.Queued, position.DepositType.String())
+        }
+        if position.QueuePosition != 1 {
+            t.Errorf("Incorrect minipool queue position %d", position.QueuePosition)
+        }
+        if position.AssignmentPosition != 2 {
+            t.Errorf("Incorrect minipool assignment position %d", position.AssignmentPosition)
+        }
+    }
+
+    // Get & check node queued minipools
+    if queued, err := minipool.GetNodeQueuedMinipools(rp, nodeAccount.Address, nil); err != nil {
+        t.Error(err)
+    } else if len(queued) != 3 {
+        t.Errorf("Incorrect node queued minipool count %d", len(queued))
+    } else {
+        for qi, queuedMinipool := range queued {
+            if !bytes.Equal(queuedMinipool.Address.Bytes(), expected[qi].Address.Bytes()) {
+                t.Errorf("Incorrect node queued minipool %d address %s", qi, queuedMinipool.Address.Hex())
+            }
+            if queuedMinipool.AssignmentPosition != uint64(qi) {
+                t.Errorf("Incorrect node queued minipool %d assignment position %d", qi, queuedMinipool.AssignmentPosition)
+            }
+            expectedStatus := rptypes.Initialized
+            if queuedMinipool.DepositType == rptypes.Full { expectedStatus = rptypes.Prelaunch }
+            if queuedMinipool.Status.Status != expectedStatus {
+                t.Errorf("Incorrect node queued minipool %d status %s", qi, queuedMinipool.Status.Status.String())
+            }
+        }
+    }
+
+    // Assign user deposits & check minipool queue position
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+    if position, err := minipool.GetMinipoolQueuePosition(rp, halfMinipool.Address, nil); err != nil {
+        t.Error(err)
+    } else if position.Queued {
+        t.Error("Incorrect assigned minipool queue status")
+    }
+
+}
+
@@ -0,0 +1,116 @@
+package minipool
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+)
+
+
+// Node reward scenarios
+type rewardScenario struct {
+    nodeFee float64
+    userDepositBalance *big.Int
+    startBalance *big.Int
+    endBalance *big.Int
+}
+var rewardScenarios = []rewardScenario{
+    {0.5, eth.EthToWei(16), eth.EthToWei(40), eth.EthToWei(48)},
+    {0.1, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(32)},
+    {0.1, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(33.3333)},
+    {0.15, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(31)},
+    {0.15, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(12)},
+    {0.2, eth.EthToWei(32), eth.EthToWei(32), eth.EthToWei(34)},
+    {0.2, eth.EthToWei(0), eth.EthToWei(32), eth.EthToWei(35.123456789)},
+    {0.05, big.NewInt(7), big.NewInt(13), big.NewInt(29)},
+}
+
+
+func TestCalculateNodeRewardAmount(t *testing.T) {
+
+    // Get & check node reward amount
+    // Node reward amount = (node balance) + (rewards * node balance / start balance) + (rewards * user balance / start balance * node fee)
+    //                    = (40 - 16) +      (48 - 40) * (40 - 16) / 40 +               (48 - 40) * 16 / 40 * 0.5
+    //                    = 30.4
+    if rewardAmount := minipool.CalculateNodeRewardAmount(0.5, eth.EthToWei(16), eth.EthToWei(40), eth.EthToWei(48)); rewardAmount.Cmp(eth.EthToWei(30.4)) != 0 {
+        t.Errorf("Incorrect minipool node reward amount %s", rewardAmount.String())
+    }
+
+    // Get & check node reward amount with losses
+    if rewardAmount := minipool.CalculateNodeRewardAmount(0.5, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(31)); rewardAmount.Cmp(eth.EthToWei(15)) != 0 {
+        t.Errorf("Incorrect minipool node reward amount %s", rewardAmount.String())
+    }
+    if rewardAmount := minipool.CalculateNodeRewardAmount(0.5, eth.EthToWei(16), eth.EthToWei(32), eth.EthToWei(12)); rewardAmount.Sign() != 0 {
+        t.Errorf("Incorrect minipool node reward amount %s", rewardAmount.String())
+    }
+
+    // Get & check node reward amount without a start balance
+    if rewardAmount := minipool.CalculateNodeRewardAmount(0.5, eth.EthToWei(16), big.NewInt(0), eth.EthToWei(32)); rewardAmount.Sign() != 0 {
+        t.Errorf("Incorrect minipool node reward amount %s without a start balance", rewardAmount.String())
+    }
+
+}
+
+
+func TestCalculateNodeRewardAmountMatchesNetwork(t *testing.T) {
+    for _, scenario := range rewardScenarios {
+        expected, err := minipool.GetMinipoolNodeRewardAmount(rp, scenario.nodeFee, scenario.userDepositBalance, scenario.startBalance, scenario.endBalance, nil)
+        if err != nil { t.Fatal(err) }
+        if rewardAmount := minipool.CalculateNodeRewardAmount(scenario.nodeFee, scenario.userDepositBalance, scenario.startBalance, scenario.endBalance); rewardAmount.Cmp(expected) != 0 {
+            t.Errorf("Incorrect minipool node reward amount %s for scenario %v, expected %s", rewardAmount.String(), scenario, expected.String())
+        }
+    }
+}
+
+
+func TestProjectNodeRewards(t *testing.T) {
+
+    // Get & check projection
+    // Node rewards = (rewards * node balance / start balance) + (rewards * user balance / start balance * node fee)
+    //              = (32 * 0.1) * 16 / 32 +                     (32 * 0.1) * 16 / 32 * 0.2
+    //              = 1.92
+    projection, err := minipool.ProjectNodeRewards(0.2, eth.EthToWei(16), eth.EthToWei(32), 0.1)
+    if err != nil { t.Fatal(err) }
+    if projection.NodeCapital.Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect projected node capital %s", projection.NodeCapital.String())
+    }
+    if projection.EndBalance.Cmp(eth.EthToWei(35.2)) != 0 {
+        t.Errorf("Incorrect projected end balance %s", projection.EndBalance.String())
+    }
+    if projection.NodeRewards.Cmp(eth.EthToWei(1.92)) != 0 {
+        t.Errorf("Incorrect projected node rewards %s", projection.NodeRewards.String())
+    }
+    if projection.NodeAPR != 0.12 {
+        t.Errorf("Incorrect projected node APR %f", projection.NodeAPR)
+    }
+
+    // Check projections without node capital
+    if _, err := minipool.ProjectNodeRewards(0.2, eth.EthToWei(32), eth.EthToWei(32), 0.1); err == nil {
+        t.Error("Projected rewards for a minipool without node capital")
+    }
+
+}
+
+
+func TestGetNodeRewardProjection(t *testing.T) {
+
+    // Get & check projections by deposit type
+    if projection, err := minipool.GetNodeRewardProjection(rp, rptypes.Full, 0.2, 0.1, nil); err != nil {
+        t.Error(err)
+    } else if projection.NodeAPR <= 0.1 {
+        t.Errorf("Incorrect full deposit projected node APR %f", projection.NodeAPR)
+    }
+    if projection, err := minipool.GetNodeRewardProjection(rp, rptypes.Half, 0.2, 0.1, nil); err != nil {
+        t.Error(err)
+    } else if projection.NodeAPR <= 0.1 {
+        t.Errorf("Incorrect half deposit projected node APR %f", projection.NodeAPR)
+    }
+    if _, err := minipool.GetNodeRewardProjection(rp, rptypes.Empty, 0.2, 0.1, nil); err == nil {
+        t.Error("Projected rewards for an empty deposit minipool")
+    }
+
+}
+
@@ -0,0 +1,77 @@
+package minipool
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestSnapshots(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    mp1, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    mp2, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Make user deposit
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+
+    // Get & check node minipool snapshots
+    snapshots, err := minipool.GetNodeMinipoolSnapshots(rp, nodeAccount.Address, nil)
+    if err != nil {
+        t.Fatal(err)
+    } else if len(snapshots) != 2 {
+        t.Fatalf("Incorrect node minipool snapshot count %d", len(snapshots))
+    }
+    for _, snapshot := range snapshots {
+        if snapshot.Block == 0 {
+            t.Error("Incorrect minipool snapshot block")
+        }
+        if snapshot.Block != snapshots[0].Block {
+            t.Error("Minipool snapshots were not loaded at the same block")
+        }
+        if !snapshot.Exists {
+            t.Errorf("Incorrect minipool %s exists status", snapshot.Address.Hex())
+        }
+        if !bytes.Equal(snapshot.Node.Address.Bytes(), nodeAccount.Address.Bytes()) {
+            t.Errorf("Incorrect minipool %s node address %s", snapshot.Address.Hex(), snapshot.Node.Address.Hex())
+        }
+        switch {
+            case bytes.Equal(snapshot.Address.Bytes(), mp1.Address.Bytes()):
+                if snapshot.DepositType != rptypes.Full {
+                    t.Errorf("Incorrect minipool deposit type %s", snapshot.DepositType.String())
+                }
+                if snapshot.Status.Status != rptypes.Prelaunch {
+                    t.Errorf("Incorrect minipool status %s", snapshot.Status.Status.String())
+                }
+            case bytes.Equal(snapshot.Address.Bytes(), mp2.Address.Bytes()):
+                if snapshot.DepositType != rptypes.Half {
+                    t.Errorf("Incorrect minipool deposit type %s", snapshot.DepositType.String())
+                }
+                if snapshot.Balance.Cmp(eth.EthToWei(16)) == -1 {
+                    t.Errorf("Incorrect minipool balance %s", snapshot.Balance.String())
+                }
+        }
+    }
+
+}
+
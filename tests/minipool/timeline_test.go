@@ -0,0 +1,101 @@
+package minipool
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/minipool"
+    "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+    nodeutils "github.com/rocket-pool/rocketpool-go/tests/testutils/node"
+)
+
+
+func TestStatusTimeline(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if err := nodeutils.RegisterTrustedNode(rp, ownerAccount, trustedNodeAccount); err != nil { t.Fatal(err) }
+
+    // Create minipool
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16))
+    if err != nil { t.Fatal(err) }
+
+    // Get & check initial timeline
+    if timeline, err := mp.GetStatusTimeline(nil); err != nil {
+        t.Error(err)
+    } else if len(timeline.Transitions) != 1 || timeline.Transitions[0].Status != rptypes.Initialized {
+        t.Errorf("Incorrect initial minipool status timeline %v", timeline.Transitions)
+    }
+
+    // Make user deposit, stake minipool & set withdrawable
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+    if err := evm.IncreaseTime(60); err != nil { t.Fatal(err) }
+    if err := minipoolutils.StakeMinipool(rp, mp, nodeAccount); err != nil { t.Fatal(err) }
+    if err := evm.IncreaseTime(60); err != nil { t.Fatal(err) }
+    if _, err := minipool.SubmitMinipoolWithdrawable(rp, mp.Address, eth.EthToWei(32), eth.EthToWei(32), trustedNodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Get timeline
+    timeline, err := mp.GetStatusTimeline(nil)
+    if err != nil { t.Fatal(err) }
+
+    // Check transitions
+    expected := []struct{
+        status rptypes.MinipoolStatus
+        actor common.Address
+    }{
+        {rptypes.Initialized, nodeAccount.Address},
+        {rptypes.Prelaunch, userAccount.Address},
+        {rptypes.Staking, nodeAccount.Address},
+        {rptypes.Withdrawable, trustedNodeAccount.Address},
+    }
+    if len(timeline.Transitions) != len(expected) {
+        t.Fatalf("Incorrect minipool status transition count %d", len(timeline.Transitions))
+    }
+    for ti, transition := range timeline.Transitions {
+        if transition.Status != expected[ti].status {
+            t.Errorf("Incorrect minipool status transition %d status %s", ti, transition.Status.String())
+        }
+        if !bytes.Equal(transition.Actor.Bytes(), expected[ti].actor.Bytes()) {
+            t.Errorf("Incorrect minipool status transition %d actor %s", ti, transition.Actor.Hex())
+        }
+        if transition.Block == 0 || transition.Time.IsZero() || transition.TxHash == (common.Hash{}) {
+            t.Errorf("Incorrect minipool status transition %d details %v", ti, transition)
+        }
+        if transition.Duration < 0 {
+            t.Errorf("Incorrect minipool status transition %d duration %s", ti, transition.Duration.String())
+        }
+    }
+
+    // Check current status details match timeline
+    if status, err := mp.GetStatusDetails(nil); err != nil {
+        t.Error(err)
+    } else if status.StatusBlock != timeline.Transitions[3].Block {
+        t.Errorf("Incorrect minipool status transition block %d", timeline.Transitions[3].Block)
+    }
+
+    // Check durations between stages
+    if duration, ok := timeline.GetDurationBetween(rptypes.Prelaunch, rptypes.Withdrawable); !ok {
+        t.Error("Could not get duration between prelaunch and withdrawable statuses")
+    } else if duration.Seconds() < 120 {
+        t.Errorf("Incorrect duration between prelaunch and withdrawable statuses %s", duration.String())
+    }
+    if _, ok := timeline.GetDurationBetween(rptypes.Initialized, rptypes.Dissolved); ok {
+        t.Error("Got duration to a status the minipool never entered")
+    }
+
+}
+
@@ -0,0 +1,75 @@
+package node
+
+import (
+    "encoding/json"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestNodeDashboard(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create full minipool & assign user deposit to it
+    if _, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32)); err != nil { t.Fatal(err) }
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+
+    // Create half minipool, which remains unassigned
+    if _, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(16)); err != nil { t.Fatal(err) }
+
+    // Get dashboard
+    dashboard, err := node.GetNodeDashboard(rp, nodeAccount.Address, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Check node details
+    if !dashboard.Exists {
+        t.Error("Incorrect dashboard node exists status")
+    }
+    if dashboard.TimezoneLocation != "Australia/Brisbane" {
+        t.Errorf("Incorrect dashboard node timezone location %s", dashboard.TimezoneLocation)
+    }
+    if dashboard.Balances.ETH == nil || dashboard.Balances.ETH.Sign() == 0 {
+        t.Error("Incorrect dashboard node ETH balance")
+    }
+
+    // Check minipool totals
+    if len(dashboard.Minipools) != 2 {
+        t.Errorf("Incorrect dashboard minipool count %d", len(dashboard.Minipools))
+    }
+    if dashboard.MinipoolCounts["Prelaunch"] != 1 || dashboard.MinipoolCounts["Initialized"] != 1 {
+        t.Errorf("Incorrect dashboard minipool status counts %v", dashboard.MinipoolCounts)
+    }
+    if dashboard.StakedByStatus["Prelaunch"].Cmp(eth.EthToWei(32)) != 0 {
+        t.Errorf("Incorrect dashboard prelaunch staked balance %s", dashboard.StakedByStatus["Prelaunch"].String())
+    }
+    if dashboard.NodeStakedByStatus["Prelaunch"].Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect dashboard prelaunch node staked balance %s", dashboard.NodeStakedByStatus["Prelaunch"].String())
+    }
+    if dashboard.NodeStakedByStatus["Initialized"].Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect dashboard initialized node staked balance %s", dashboard.NodeStakedByStatus["Initialized"].String())
+    }
+    if dashboard.RefundBalance.Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect dashboard refund balance %s", dashboard.RefundBalance.String())
+    }
+
+    // Check dashboard is serialisable
+    if _, err := json.Marshal(dashboard); err != nil {
+        t.Error(err)
+    }
+
+}
+
@@ -0,0 +1,67 @@
+package node
+
+import (
+    "context"
+    "testing"
+    "time"
+
+    "github.com/rocket-pool/rocketpool-go/network"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+)
+
+
+func TestDepositAtNodeFee(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Get current node fee
+    nodeFee, err := network.GetNodeFee(rp, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Check deposit times out while node fee is below target
+    opts := nodeAccount.GetTransactor()
+    opts.Value = eth.EthToWei(16)
+    if _, err := node.DepositAtNodeFee(context.Background(), rp, node.ConditionalDepositConfig{
+        TargetNodeFee: nodeFee + 0.01,
+        Deadline: time.Now().Add(time.Second),
+        PollInterval: 100 * time.Millisecond,
+    }, opts); err != node.ErrNodeFeeDeadlineExceeded {
+        t.Errorf("Incorrect conditional deposit error %v while node fee is below target", err)
+    }
+
+    // Check deposit is cancelled
+    ctx, cancel := context.WithCancel(context.Background())
+    cancel()
+    if _, err := node.DepositAtNodeFee(ctx, rp, node.ConditionalDepositConfig{
+        TargetNodeFee: nodeFee + 0.01,
+    }, opts); err != context.Canceled {
+        t.Errorf("Incorrect conditional deposit error %v after cancellation", err)
+    }
+
+    // Deposit at current node fee
+    var checkedFee float64
+    result, err := node.DepositAtNodeFee(context.Background(), rp, node.ConditionalDepositConfig{
+        TargetNodeFee: nodeFee,
+        Deadline: time.Now().Add(time.Minute),
+        OnNodeFee: func(block uint64, fee float64) { checkedFee = fee },
+    }, opts)
+    if err != nil { t.Fatal(err) }
+
+    // Check deposit result
+    if checkedFee != nodeFee {
+        t.Errorf("Incorrect checked node fee %f", checkedFee)
+    }
+    if result.NodeFee < nodeFee {
+        t.Errorf("Incorrect deposit result node fee %f", result.NodeFee)
+    }
+
+}
+
@@ -0,0 +1,125 @@
+package node
+
+import (
+    "math/big"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/settings"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+)
+
+
+func TestCheckDeposit(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Check deposit from unregistered node with invalid amount
+    if preflight, err := node.CheckDeposit(rp, nodeAccount.Address, eth.EthToWei(10), 0, nil); err != nil {
+        t.Fatal(err)
+    } else if preflight.CanDeposit {
+        t.Error("Deposit preflight passed for unregistered node")
+    } else if !hasReason(preflight.Reasons, node.DepositNodeNotRegistered) || !hasReason(preflight.Reasons, node.DepositInvalidAmount) {
+        t.Errorf("Incorrect deposit preflight reasons %v", preflight.Reasons)
+    }
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check valid deposit
+    if preflight, err := node.CheckDeposit(rp, nodeAccount.Address, eth.EthToWei(16), 0, nil); err != nil {
+        t.Fatal(err)
+    } else if !preflight.CanDeposit {
+        t.Errorf("Deposit preflight failed with reasons %v", preflight.Reasons)
+    } else if preflight.DepositType != rptypes.Half {
+        t.Errorf("Incorrect deposit preflight deposit type %s", preflight.DepositType.String())
+    }
+
+    // Disable node deposits & check deposit
+    if _, err := settings.SetNodeDepositEnabled(rp, false, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if preflight, err := node.CheckDeposit(rp, nodeAccount.Address, eth.EthToWei(16), 1, nil); err != nil {
+        t.Fatal(err)
+    } else if preflight.CanDeposit {
+        t.Error("Deposit preflight passed while node deposits are disabled")
+    } else if !hasReason(preflight.Reasons, node.DepositNodeDepositsDisabled) || !hasReason(preflight.Reasons, node.DepositNodeFeeTooLow) {
+        t.Errorf("Incorrect deposit preflight reasons %v", preflight.Reasons)
+    }
+
+}
+
+
+func TestDepositPreflight(t *testing.T) {
+
+    // Initialize state
+    state := node.DepositPreflightState{
+        NodeExists: true,
+        NodeDepositEnabled: true,
+        AssignDepositsEnabled: true,
+        DepositAmount: eth.EthToWei(16),
+        AccountBalance: eth.EthToWei(100),
+        MinimumNodeFee: 0.05,
+        NodeFee: 0.1,
+        NodeAmounts: map[rptypes.MinipoolDeposit]*big.Int{rptypes.Full: eth.EthToWei(32), rptypes.Half: eth.EthToWei(16), rptypes.Empty: big.NewInt(0)},
+        UserAmounts: map[rptypes.MinipoolDeposit]*big.Int{rptypes.Full: eth.EthToWei(16), rptypes.Half: eth.EthToWei(16), rptypes.Empty: eth.EthToWei(32)},
+        QueueLengths: map[rptypes.MinipoolDeposit]uint64{rptypes.Full: 0, rptypes.Half: 1, rptypes.Empty: 0},
+        DepositPoolBalance: eth.EthToWei(32),
+        MaxDepositAssignments: 2,
+    }
+
+    // Check assigned deposit
+    if preflight := node.GetDepositPreflight(state); !preflight.CanDeposit {
+        t.Errorf("Deposit preflight failed with reasons %v", preflight.Reasons)
+    } else if preflight.DepositType != rptypes.Half {
+        t.Errorf("Incorrect deposit preflight deposit type %s", preflight.DepositType.String())
+    } else if len(preflight.Warnings) != 0 {
+        t.Errorf("Incorrect deposit preflight warnings %v", preflight.Warnings)
+    }
+
+    // Check unassigned deposit
+    state.DepositPoolBalance = eth.EthToWei(16)
+    if preflight := node.GetDepositPreflight(state); !preflight.CanDeposit {
+        t.Errorf("Deposit preflight failed with reasons %v", preflight.Reasons)
+    } else if !hasWarning(preflight.Warnings, node.DepositNotAssignedImmediately) {
+        t.Errorf("Incorrect deposit preflight warnings %v", preflight.Warnings)
+    }
+
+    // Check empty deposit from untrusted node with insufficient balance
+    state.DepositAmount = big.NewInt(0)
+    if preflight := node.GetDepositPreflight(state); preflight.CanDeposit {
+        t.Error("Deposit preflight passed for empty deposit from untrusted node")
+    } else if preflight.DepositType != rptypes.Empty || !hasReason(preflight.Reasons, node.DepositEmptyNotTrusted) {
+        t.Errorf("Incorrect deposit preflight result %s %v", preflight.DepositType.String(), preflight.Reasons)
+    }
+    state.DepositAmount = eth.EthToWei(32)
+    state.AccountBalance = eth.EthToWei(1)
+    if preflight := node.GetDepositPreflight(state); preflight.CanDeposit {
+        t.Error("Deposit preflight passed with insufficient account balance")
+    } else if len(preflight.Reasons) != 1 || preflight.Reasons[0] != node.DepositInsufficientBalance {
+        t.Errorf("Incorrect deposit preflight reasons %v", preflight.Reasons)
+    }
+
+}
+
+
+// Check whether a list of deposit block reasons contains a reason
+func hasReason(reasons []node.DepositBlockReason, reason node.DepositBlockReason) bool {
+    for _, r := range reasons {
+        if r == reason { return true }
+    }
+    return false
+}
+
+
+// Check whether a list of deposit warnings contains a warning
+func hasWarning(warnings []node.DepositWarning, warning node.DepositWarning) bool {
+    for _, w := range warnings {
+        if w == warning { return true }
+    }
+    return false
+}
+
@@ -1,10 +1,13 @@
 package node
 
 import (
+    "bytes"
     "testing"
 
+    "github.com/rocket-pool/rocketpool-go/deposit"
     "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/node"
+    rptypes "github.com/rocket-pool/rocketpool-go/types"
     "github.com/rocket-pool/rocketpool-go/utils/eth"
 
     "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
@@ -31,7 +34,8 @@ func TestDeposit(t *testing.T) {
     // Deposit
     opts := nodeAccount.GetTransactor()
     opts.Value = eth.EthToWei(16)
-    if _, err := node.Deposit(rp, 0, opts); err != nil {
+    result, err := node.DepositWithResult(rp, 0, opts)
+    if err != nil {
         t.Fatal(err)
     }
 
@@ -43,5 +47,58 @@ func TestDeposit(t *testing.T) {
         t.Error("Incorrect node minipool count")
     }
 
+    // Check deposit result
+    if minipoolAddress, err := minipool.GetNodeMinipoolAt(rp, nodeAccount.Address, minipoolCount1, nil); err != nil {
+        t.Error(err)
+    } else if !bytes.Equal(result.MinipoolAddress.Bytes(), minipoolAddress.Bytes()) {
+        t.Errorf("Incorrect deposit result minipool address %s", result.MinipoolAddress.Hex())
+    }
+    if result.Minipool == nil || !bytes.Equal(result.Minipool.Address.Bytes(), result.MinipoolAddress.Bytes()) {
+        t.Error("Incorrect deposit result minipool")
+    }
+    if result.DepositType != rptypes.Half {
+        t.Errorf("Incorrect deposit result deposit type %s", result.DepositType.String())
+    }
+    if result.Status != rptypes.Initialized {
+        t.Errorf("Incorrect deposit result minipool status %s", result.Status.String())
+    }
+    if result.UserDepositAssigned {
+        t.Error("Incorrect deposit result user deposit assigned status")
+    }
+
+}
+
+
+func TestDepositAssigned(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Make user deposit
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+
+    // Deposit
+    opts := nodeAccount.GetTransactor()
+    opts.Value = eth.EthToWei(16)
+    result, err := node.DepositWithResult(rp, 0, opts)
+    if err != nil { t.Fatal(err) }
+
+    // Check deposit result
+    if result.Status != rptypes.Prelaunch {
+        t.Errorf("Incorrect deposit result minipool status %s", result.Status.String())
+    }
+    if !result.UserDepositAssigned {
+        t.Error("Incorrect deposit result user deposit assigned status")
+    }
+    if result.UserDepositBalance.Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect deposit result user deposit balance %s", result.UserDepositBalance.String())
+    }
+
 }
 
@@ -19,7 +19,9 @@ var (
     client *ethclient.Client
     rp *rocketpool.RocketPool
 
+    ownerAccount *accounts.Account
     nodeAccount *accounts.Account
+    userAccount *accounts.Account
 )
 
 
@@ -35,8 +37,12 @@ func TestMain(m *testing.M) {
     if err != nil { log.Fatal(err) }
 
     // Initialize accounts
+    ownerAccount, err = accounts.GetAccount(0)
+    if err != nil { log.Fatal(err) }
     nodeAccount, err = accounts.GetAccount(1)
     if err != nil { log.Fatal(err) }
+    userAccount, err = accounts.GetAccount(9)
+    if err != nil { log.Fatal(err) }
 
     // Run tests
     os.Exit(m.Run())
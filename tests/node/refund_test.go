@@ -0,0 +1,78 @@
+package node
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/deposit"
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/utils/eth"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+    minipoolutils "github.com/rocket-pool/rocketpool-go/tests/testutils/minipool"
+)
+
+
+func TestRefundMinipools(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Create minipools
+    mp, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32))
+    if err != nil { t.Fatal(err) }
+    if _, err := minipoolutils.CreateMinipool(rp, nodeAccount, eth.EthToWei(32)); err != nil { t.Fatal(err) }
+
+    // Make user deposit
+    depositOpts := userAccount.GetTransactor();
+    depositOpts.Value = eth.EthToWei(16)
+    if _, err := deposit.Deposit(rp, depositOpts); err != nil { t.Fatal(err) }
+
+    // Get & check node minipool refund balances
+    if refundBalances, err := node.GetNodeMinipoolRefundBalances(rp, nodeAccount.Address, nil); err != nil {
+        t.Fatal(err)
+    } else if len(refundBalances) != 1 {
+        t.Fatalf("Incorrect refundable minipool count %d", len(refundBalances))
+    } else if !bytes.Equal(refundBalances[0].Minipool.Bytes(), mp.Address.Bytes()) {
+        t.Errorf("Incorrect refundable minipool %s", refundBalances[0].Minipool.Hex())
+    } else if refundBalances[0].RefundBalance.Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect minipool refund balance %s", refundBalances[0].RefundBalance.String())
+    }
+
+    // Refund minipools
+    summary, err := node.RefundMinipools(rp, nodeAccount.GetTransactor())
+    if err != nil { t.Fatal(err) }
+
+    // Check refund summary
+    if len(summary.Refunds) != 1 {
+        t.Fatalf("Incorrect refund count %d", len(summary.Refunds))
+    } else if !summary.Refunds[0].Refunded {
+        t.Errorf("Minipool was not refunded: %s", summary.Refunds[0].Error)
+    }
+    if summary.TotalRefunded.Cmp(eth.EthToWei(16)) != 0 {
+        t.Errorf("Incorrect total refunded amount %s", summary.TotalRefunded.String())
+    }
+    if summary.NetRecovered.Cmp(summary.TotalRefunded) >= 0 {
+        t.Errorf("Incorrect net recovered amount %s", summary.NetRecovered.String())
+    }
+
+    // Check minipool refund balance
+    if refundBalance, err := mp.GetNodeRefundBalance(nil); err != nil {
+        t.Error(err)
+    } else if refundBalance.Sign() != 0 {
+        t.Errorf("Incorrect minipool refund balance %s after refunding", refundBalance.String())
+    }
+
+    // Check no further refunds are made
+    if summary, err := node.RefundMinipools(rp, nodeAccount.GetTransactor()); err != nil {
+        t.Fatal(err)
+    } else if len(summary.Refunds) != 0 {
+        t.Errorf("Incorrect refund count %d after refunding", len(summary.Refunds))
+    }
+
+}
+
@@ -0,0 +1,96 @@
+package node
+
+import (
+    "testing"
+    "time"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+)
+
+
+func TestValidateTimezoneLocation(t *testing.T) {
+    for _, timezoneLocation := range []string{"Australia/Brisbane", "America/New_York", "UTC"} {
+        if err := node.ValidateTimezoneLocation(timezoneLocation); err != nil {
+            t.Errorf("Valid timezone location '%s' failed validation: %s", timezoneLocation, err)
+        }
+    }
+    for _, timezoneLocation := range []string{"", "Local", "Australia/Nowhere", "../etc/passwd"} {
+        if err := node.ValidateTimezoneLocation(timezoneLocation); err == nil {
+            t.Errorf("Invalid timezone location '%s' passed validation", timezoneLocation)
+        }
+    }
+}
+
+
+func TestRegisterNodeInvalidTimezone(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Attempt to register node with invalid timezone
+    if _, err := node.RegisterNode(rp, "Australia/Nowhere", nodeAccount.GetTransactor()); err == nil {
+        t.Error("Registered node with invalid timezone location")
+    }
+
+    // Check node was not registered
+    if exists, err := node.GetNodeExists(rp, nodeAccount.Address, nil); err != nil {
+        t.Error(err)
+    } else if exists {
+        t.Error("Node with invalid timezone location was registered")
+    }
+
+}
+
+
+func TestGeographyReport(t *testing.T) {
+
+    // Create report
+    nodes := []node.NodeDetails{
+        {Address: common.HexToAddress("0x01"), Exists: true, Trusted: true, TimezoneLocation: "Australia/Brisbane"},
+        {Address: common.HexToAddress("0x02"), Exists: true, TimezoneLocation: "Australia/Brisbane"},
+        {Address: common.HexToAddress("0x03"), Exists: true, TimezoneLocation: "Australia/Sydney"},
+        {Address: common.HexToAddress("0x04"), Exists: true, TimezoneLocation: "America/New_York"},
+        {Address: common.HexToAddress("0x05"), Exists: true, TimezoneLocation: "Nowhere"},
+        {Address: common.HexToAddress("0x06"), Exists: false, TimezoneLocation: "Europe/London"},
+    }
+    report := node.NewGeographyReport(nodes, time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+    // Check node counts
+    if report.NodeCount != 5 || report.TrustedNodeCount != 1 {
+        t.Errorf("Incorrect report node counts %d, %d", report.NodeCount, report.TrustedNodeCount)
+    }
+
+    // Check timezones
+    if len(report.Timezones) != 4 {
+        t.Fatalf("Incorrect report timezone count %d", len(report.Timezones))
+    } else if report.Timezones[0].Name != "Australia/Brisbane" || report.Timezones[0].NodeCount != 2 || report.Timezones[0].TrustedNodeCount != 1 {
+        t.Errorf("Incorrect report timezone %+v", report.Timezones[0])
+    } else if report.Timezones[0].NodeShare != 0.4 {
+        t.Errorf("Incorrect report timezone node share %f", report.Timezones[0].NodeShare)
+    }
+
+    // Check regions
+    if len(report.Regions) != 3 {
+        t.Fatalf("Incorrect report region count %d", len(report.Regions))
+    } else if report.Regions[0].Name != "Australia" || report.Regions[0].NodeCount != 3 {
+        t.Errorf("Incorrect report region %+v", report.Regions[0])
+    }
+
+    // Check UTC offsets
+    expectedOffsets := []string{"UTC-05:00", "UTC+10:00", "UTC+11:00", node.InvalidTimezoneGroup}
+    if len(report.UTCOffsets) != len(expectedOffsets) {
+        t.Fatalf("Incorrect report UTC offset count %d", len(report.UTCOffsets))
+    }
+    for oi, offset := range report.UTCOffsets {
+        if offset.Name != expectedOffsets[oi] {
+            t.Errorf("Incorrect report UTC offset %d name %s", oi, offset.Name)
+        }
+    }
+
+}
+
@@ -0,0 +1,87 @@
+package node
+
+import (
+    "bytes"
+    "testing"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+)
+
+
+func TestRequiredSubmissionCount(t *testing.T) {
+    for _, test := range []struct{
+        trustedNodeCount uint64
+        consensusThreshold float64
+        required uint64
+    }{
+        {0, 0.51, 1},
+        {1, 0.51, 1},
+        {2, 0.51, 2},
+        {3, 0.51, 2},
+        {4, 0.51, 3},
+        {3, 0.66, 2},
+        {3, 0.67, 3},
+        {10, 0.5, 5},
+        {10, 1, 10},
+        {10, 1.1, 11},
+    } {
+        if required := node.GetRequiredSubmissionCount(test.trustedNodeCount, test.consensusThreshold); required != test.required {
+            t.Errorf("Incorrect required submission count %d for %d trusted nodes at threshold %f", required, test.trustedNodeCount, test.consensusThreshold)
+        }
+    }
+}
+
+
+func TestTrustedNodeHistory(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Get initial history & quorum
+    history1, err := node.GetTrustedNodeHistory(rp, nil)
+    if err != nil { t.Fatal(err) }
+    quorum1, err := node.GetTrustedNodeQuorum(rp, nil)
+    if err != nil { t.Fatal(err) }
+    if uint64(len(history1.Members)) != quorum1.TrustedNodeCount {
+        t.Errorf("Incorrect trusted node history member count %d", len(history1.Members))
+    }
+
+    // Register trusted node
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if _, err := node.SetNodeTrusted(rp, nodeAccount.Address, true, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check quorum
+    if quorum2, err := node.GetTrustedNodeQuorum(rp, nil); err != nil {
+        t.Fatal(err)
+    } else if quorum2.TrustedNodeCount != quorum1.TrustedNodeCount + 1 {
+        t.Errorf("Incorrect trusted node count %d", quorum2.TrustedNodeCount)
+    } else if quorum2.RequiredSubmissions != node.GetRequiredSubmissionCount(quorum2.TrustedNodeCount, quorum2.ConsensusThreshold) || !quorum2.Reachable {
+        t.Errorf("Incorrect trusted node quorum %+v", quorum2)
+    }
+
+    // Remove trusted node
+    if _, err := node.SetNodeTrusted(rp, nodeAccount.Address, false, ownerAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Check history
+    history2, err := node.GetTrustedNodeHistory(rp, nil)
+    if err != nil { t.Fatal(err) }
+    if len(history2.Changes) != len(history1.Changes) + 2 {
+        t.Fatalf("Incorrect trusted node history change count %d", len(history2.Changes))
+    }
+    added := history2.Changes[len(history2.Changes) - 2]
+    removed := history2.Changes[len(history2.Changes) - 1]
+    if !bytes.Equal(added.Node.Bytes(), nodeAccount.Address.Bytes()) || !added.Trusted || added.TrustedNodeCount != uint64(len(history1.Members)) + 1 {
+        t.Errorf("Incorrect trusted node addition %+v", added)
+    }
+    if !bytes.Equal(removed.Node.Bytes(), nodeAccount.Address.Bytes()) || removed.Trusted || removed.TrustedNodeCount != uint64(len(history1.Members)) {
+        t.Errorf("Incorrect trusted node removal %+v", removed)
+    }
+    if len(history2.Members) != len(history1.Members) {
+        t.Errorf("Incorrect trusted node history member count %d", len(history2.Members))
+    }
+
+}
+
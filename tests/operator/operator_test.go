@@ -0,0 +1,178 @@
+package operator
+
+import (
+    "bytes"
+    "crypto/ecdsa"
+    "encoding/hex"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/accounts/keystore"
+    "github.com/ethereum/go-ethereum/crypto"
+
+    "github.com/rocket-pool/rocketpool-go/node"
+    "github.com/rocket-pool/rocketpool-go/operator"
+
+    "github.com/rocket-pool/rocketpool-go/tests"
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/evm"
+)
+
+
+// Test account mnemonic
+const mnemonic = "jungle neck govern chief unaware rubber frequent tissue service license alcohol velvet"
+
+
+func TestLoadMnemonic(t *testing.T) {
+
+    // Load accounts
+    manager := operator.NewManager(rp, operator.ManagerConfig{})
+    loaded, err := manager.LoadMnemonic(mnemonic, "", operator.DefaultDerivationPath, 0, uint(len(tests.AccountPrivateKeys)))
+    if err != nil { t.Fatal(err) }
+    if len(loaded) != len(tests.AccountPrivateKeys) {
+        t.Fatalf("Incorrect loaded account count %d", len(loaded))
+    }
+
+    // Check derived accounts
+    for ai, account := range loaded {
+        privateKey, err := crypto.HexToECDSA(tests.AccountPrivateKeys[ai])
+        if err != nil { t.Fatal(err) }
+        if !bytes.Equal(account.Address.Bytes(), crypto.PubkeyToAddress(privateKey.PublicKey).Bytes()) {
+            t.Errorf("Incorrect derived account %d address %s", ai, account.Address.Hex())
+        }
+    }
+
+    // Check accounts are not duplicated
+    if _, err := manager.LoadMnemonic(mnemonic, "", operator.DefaultDerivationPath, 0, 2); err != nil { t.Fatal(err) }
+    if accounts := manager.GetAccounts(); len(accounts) != len(tests.AccountPrivateKeys) {
+        t.Errorf("Incorrect manager account count %d", len(accounts))
+    }
+
+    // Check invalid mnemonics are rejected
+    for _, invalidMnemonic := range []string{
+        "jungle neck govern chief unaware rubber frequent tissue service license alcohol",
+        "jungle neck govern chief unaware rubber frequent tissue service license alcohol velvett",
+        "jungle neck govern chief unaware rubber frequent tissue service license alcohol alcohol",
+    } {
+        if _, err := operator.NewManager(rp, operator.ManagerConfig{}).LoadMnemonic(invalidMnemonic, "", operator.DefaultDerivationPath, 0, 1); err == nil {
+            t.Errorf("Loaded invalid mnemonic '%s'", invalidMnemonic)
+        }
+    }
+
+    // Check passphrases are NFKD normalised
+    composed, err := operator.NewManager(rp, operator.ManagerConfig{}).LoadMnemonic(mnemonic, "caf\u00e9", operator.DefaultDerivationPath, 0, 1)
+    if err != nil { t.Fatal(err) }
+    decomposed, err := operator.NewManager(rp, operator.ManagerConfig{}).LoadMnemonic(mnemonic, "cafe\u0301", operator.DefaultDerivationPath, 0, 1)
+    if err != nil { t.Fatal(err) }
+    if !bytes.Equal(composed[0].Address.Bytes(), decomposed[0].Address.Bytes()) {
+        t.Errorf("Incorrect derived account address %s for decomposed passphrase", decomposed[0].Address.Hex())
+    }
+
+}
+
+
+func TestLoadKeystore(t *testing.T) {
+
+    // Write keystore files
+    keystoreDir := t.TempDir()
+    ks := keystore.NewKeyStore(keystoreDir, keystore.LightScryptN, keystore.LightScryptP)
+    privateKeys := []*ecdsa.PrivateKey{}
+    for ai := 0; ai < 2; ai++ {
+        privateKeyBytes, err := hex.DecodeString(tests.AccountPrivateKeys[ai])
+        if err != nil { t.Fatal(err) }
+        privateKey, err := crypto.ToECDSA(privateKeyBytes)
+        if err != nil { t.Fatal(err) }
+        privateKeys = append(privateKeys, privateKey)
+        if _, err := ks.ImportECDSA(privateKey, "password"); err != nil { t.Fatal(err) }
+    }
+
+    // Load keystore directory
+    manager := operator.NewManager(rp, operator.ManagerConfig{})
+    if _, err := manager.LoadKeystore(keystoreDir, "wrong password"); err == nil {
+        t.Error("Loaded keystore with incorrect password")
+    }
+    loaded, err := manager.LoadKeystore(keystoreDir, "password")
+    if err != nil { t.Fatal(err) }
+    if len(loaded) != len(privateKeys) {
+        t.Fatalf("Incorrect loaded account count %d", len(loaded))
+    }
+    for _, privateKey := range privateKeys {
+        if _, ok := manager.GetAccount(crypto.PubkeyToAddress(privateKey.PublicKey)); !ok {
+            t.Errorf("Keystore account %s was not loaded", crypto.PubkeyToAddress(privateKey.PublicKey).Hex())
+        }
+    }
+
+}
+
+
+func TestRun(t *testing.T) {
+
+    // State snapshotting
+    if err := evm.TakeSnapshot(); err != nil { t.Fatal(err) }
+    t.Cleanup(func() { if err := evm.RevertSnapshot(); err != nil { t.Fatal(err) } })
+
+    // Register nodes
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", nodeAccount.GetTransactor()); err != nil { t.Fatal(err) }
+    if _, err := node.RegisterNode(rp, "Australia/Brisbane", userAccount.GetTransactor()); err != nil { t.Fatal(err) }
+
+    // Load accounts & nodes
+    manager := operator.NewManager(rp, operator.ManagerConfig{})
+    manager.AddPrivateKey(nodeAccount.PrivateKey)
+    manager.AddPrivateKey(userAccount.PrivateKey)
+    if err := manager.LoadNodes(nil); err != nil { t.Fatal(err) }
+    accounts := manager.GetRegisteredAccounts()
+    if len(accounts) != 2 {
+        t.Fatalf("Incorrect registered account count %d", len(accounts))
+    }
+
+    // Set timezone locations
+    if _, err := manager.SetTimezoneLocation(accounts, "Nowhere"); err == nil {
+        t.Error("Set invalid timezone location")
+    }
+    results, err := manager.SetTimezoneLocation(accounts, "Europe/London")
+    if err != nil { t.Fatal(err) }
+    for _, result := range results {
+        if result.Error != "" {
+            t.Errorf("Could not set node %s timezone location: %s", result.Node.Hex(), result.Error)
+        } else if timezoneLocation, err := node.GetNodeTimezoneLocation(rp, result.Node, nil); err != nil {
+            t.Error(err)
+        } else if timezoneLocation != "Europe/London" {
+            t.Errorf("Incorrect node %s timezone location %s", result.Node.Hex(), timezoneLocation)
+        }
+    }
+
+    // Check an operation may send several transactions
+    results, err = manager.Run(accounts, func(account *operator.NodeAccount, opts *bind.TransactOpts) (interface{}, error) {
+        if _, err := node.SetTimezoneLocation(rp, "Europe/Paris", opts); err != nil {
+            return nil, err
+        }
+        return node.SetTimezoneLocation(rp, "Europe/London", opts)
+    })
+    if err != nil { t.Fatal(err) }
+    for _, result := range results {
+        if result.Error != "" {
+            t.Errorf("Could not set node %s timezone location twice: %s", result.Node.Hex(), result.Error)
+        }
+    }
+
+    // Check a second operation on the same accounts uses updated nonces
+    results, err = manager.RefundMinipools(accounts)
+    if err != nil { t.Fatal(err) }
+    for _, result := range results {
+        if result.Error != "" {
+            t.Errorf("Could not refund node %s minipools: %s", result.Node.Hex(), result.Error)
+        }
+    }
+
+    // Get status reports
+    results, err = manager.GetStatusReports(accounts, nil)
+    if err != nil { t.Fatal(err) }
+    for _, result := range results {
+        if result.Error != "" {
+            t.Errorf("Could not get node %s status report: %s", result.Node.Hex(), result.Error)
+        } else if dashboard, ok := result.Result.(node.NodeDashboard); !ok || dashboard.TimezoneLocation != "Europe/London" {
+            t.Errorf("Incorrect node %s status report", result.Node.Hex())
+        }
+    }
+
+}
+
@@ -0,0 +1,62 @@
+package storage
+
+import (
+    "bytes"
+    "math/big"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/ethereum/go-ethereum/crypto"
+
+    "github.com/rocket-pool/rocketpool-go/storage"
+)
+
+
+func TestKeys(t *testing.T) {
+
+    // Check string keys
+    if key := storage.ContractAddressKey("rocketDepositPool"); !bytes.Equal(key.Bytes(), crypto.Keccak256([]byte("contract.address"), []byte("rocketDepositPool"))) {
+        t.Errorf("Incorrect contract address key %s", key.Hex())
+    }
+
+    // Check packed value encoding
+    address := common.HexToAddress("0x1111111111111111111111111111111111111111")
+    packed := storage.NewKey().String("a").Address(address).Uint(1).Bool(true).Uint8(2).Packed()
+    expected := []byte("a")
+    expected = append(expected, address.Bytes()...)
+    expected = append(expected, common.LeftPadBytes(big.NewInt(1).Bytes(), 32)...)
+    expected = append(expected, 1, 2)
+    if !bytes.Equal(packed, expected) {
+        t.Errorf("Incorrect packed key data %x", packed)
+    }
+
+}
+
+
+func TestGetValues(t *testing.T) {
+
+    // Get contract addresses from storage
+    addresses, err := storage.GetAddresses(rp, []common.Hash{
+        storage.ContractAddressKey("rocketDepositPool"),
+        storage.ContractAddressKey("rocketNodeManager"),
+    }, nil)
+    if err != nil { t.Fatal(err) }
+
+    // Check against contract manager addresses
+    for ai, contractName := range []string{"rocketDepositPool", "rocketNodeManager"} {
+        if address, err := rp.GetAddress(contractName); err != nil {
+            t.Error(err)
+        } else if !bytes.Equal(address.Bytes(), addresses[ai].Bytes()) {
+            t.Errorf("Incorrect contract %s address %s", contractName, addresses[ai].Hex())
+        }
+    }
+
+    // Get contract ABI from storage
+    if abi, err := storage.GetString(rp, storage.ContractABIKey("rocketDepositPool"), nil); err != nil {
+        t.Error(err)
+    } else if abi == "" {
+        t.Error("Contract ABI was not found")
+    }
+
+}
+
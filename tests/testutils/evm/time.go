@@ -0,0 +1,43 @@
+package evm
+
+import (
+    "github.com/ethereum/go-ethereum/rpc"
+
+    "github.com/rocket-pool/rocketpool-go/tests"
+)
+
+
+// Mine a number of blocks
+func MineBlocks(count int) error {
+
+    // Initialize RPC client
+    client, err := rpc.Dial(tests.Eth1ProviderAddress)
+    if err != nil { return err }
+
+    // Make RPC calls
+    for bi := 0; bi < count; bi++ {
+        if err := client.Call(nil, "evm_mine"); err != nil { return err }
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Increase the EVM time by a number of seconds and mine a block
+func IncreaseTime(seconds int) error {
+
+    // Initialize RPC client
+    client, err := rpc.Dial(tests.Eth1ProviderAddress)
+    if err != nil { return err }
+
+    // Make RPC calls
+    if err := client.Call(nil, "evm_increaseTime", seconds); err != nil { return err }
+    if err := client.Call(nil, "evm_mine"); err != nil { return err }
+
+    // Return
+    return nil
+
+}
+
@@ -1,11 +1,8 @@
 package minipool
 
 import (
-    "errors"
     "math/big"
 
-    "github.com/ethereum/go-ethereum/common"
-
     "github.com/rocket-pool/rocketpool-go/minipool"
     "github.com/rocket-pool/rocketpool-go/node"
     "github.com/rocket-pool/rocketpool-go/rocketpool"
@@ -15,36 +12,17 @@ import (
 )
 
 
-// Minipool created event
-type minipoolCreated struct {
-    Minipool common.Address
-    Node common.Address
-    Time *big.Int
-}
-
-
 // Create a minipool
 func CreateMinipool(rp *rocketpool.RocketPool, nodeAccount *accounts.Account, depositAmount *big.Int) (*minipool.Minipool, error) {
 
     // Make node deposit
     opts := nodeAccount.GetTransactor()
     opts.Value = depositAmount
-    txReceipt, err := node.Deposit(rp, 0, opts)
+    result, err := node.DepositWithResult(rp, 0, opts)
     if err != nil { return nil, err }
 
-    // Get minipool manager contract
-    rocketMinipoolManager, err := rp.GetContract("rocketMinipoolManager")
-    if err != nil { return nil, err }
-
-    // Get created minipool address
-    minipoolCreatedEvents, err := rocketMinipoolManager.GetTransactionEvents(txReceipt, "MinipoolCreated", minipoolCreated{})
-    if err != nil || len(minipoolCreatedEvents) == 0 {
-        return nil, errors.New("Could not get minipool created event")
-    }
-    minipoolAddress := minipoolCreatedEvents[0].(minipoolCreated).Minipool
-
     // Return minipool instance
-    return minipool.NewMinipool(rp, minipoolAddress)
+    return result.Minipool, nil
 
 }
 
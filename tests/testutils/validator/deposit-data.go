@@ -2,7 +2,6 @@ package validator
 
 import (
     "github.com/ethereum/go-ethereum/common"
-    "github.com/prysmaticlabs/go-ssz"
 
     "github.com/rocket-pool/rocketpool-go/types"
 
@@ -10,19 +9,6 @@ import (
 )
 
 
-// Deposit settings
-const depositAmount = 32000000000 // gwei
-
-
-// Deposit data
-type depositData struct {
-    PublicKey []byte                `ssz-size:"48"`
-    WithdrawalCredentials []byte    `ssz-size:"32"`
-    Amount uint64
-    Signature []byte                `ssz-size:"96"`
-}
-
-
 // Get the validator pubkey
 func GetValidatorPubkey() (types.ValidatorPubkey, error) {
     return types.HexToValidatorPubkey(tests.ValidatorPubkey)
@@ -41,13 +27,19 @@ func GetWithdrawalCredentials() common.Hash {
 }
 
 
+// Get the fork version the validator deposit was signed for
+func GetDepositForkVersion() (types.ForkVersion, error) {
+    return types.HexToForkVersion(tests.DepositForkVersion)
+}
+
+
 // Get the validator deposit depositDataRoot
 func GetDepositDataRoot(validatorPubkey types.ValidatorPubkey, withdrawalCredentials common.Hash, validatorSignature types.ValidatorSignature) (common.Hash, error) {
-    return ssz.HashTreeRoot(depositData{
-        PublicKey: validatorPubkey.Bytes(),
-        WithdrawalCredentials: withdrawalCredentials[:],
-        Amount: depositAmount,
-        Signature: validatorSignature.Bytes(),
-    })
+    return types.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: withdrawalCredentials,
+        Amount: types.DepositAmount,
+        Signature: validatorSignature,
+    }.HashTreeRoot()
 }
 
@@ -0,0 +1,153 @@
+package types
+
+import (
+    "fmt"
+    "testing"
+
+    "github.com/ethereum/go-ethereum/common"
+
+    "github.com/rocket-pool/rocketpool-go/types"
+
+    "github.com/rocket-pool/rocketpool-go/tests/testutils/validator"
+)
+
+
+func TestDepositData(t *testing.T) {
+
+    // Get deposit data
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { t.Fatal(err) }
+    depositData := types.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: validator.GetWithdrawalCredentials(),
+        Amount: types.DepositAmount,
+        Signature: validatorSignature,
+    }
+
+    // Get deposit data root
+    depositDataRoot, err := depositData.HashTreeRoot()
+    if err != nil { t.Fatal(err) }
+
+    // Validate deposit data
+    if err := depositData.Validate(validator.GetWithdrawalCredentials(), depositDataRoot); err != nil {
+        t.Error(err)
+    }
+    if err := depositData.Validate(common.HexToHash("0x01"), depositDataRoot); err == nil {
+        t.Error("Deposit data with incorrect withdrawal credentials was validated")
+    }
+    if err := depositData.Validate(validator.GetWithdrawalCredentials(), common.HexToHash("0x01")); err == nil {
+        t.Error("Deposit data with incorrect deposit data root was validated")
+    }
+
+}
+
+
+func TestDepositSignature(t *testing.T) {
+
+    // Get deposit data
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { t.Fatal(err) }
+    forkVersion, err := validator.GetDepositForkVersion()
+    if err != nil { t.Fatal(err) }
+    depositData := types.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: validator.GetWithdrawalCredentials(),
+        Amount: types.DepositAmount,
+        Signature: validatorSignature,
+    }
+
+    // Verify deposit signature
+    if err := depositData.VerifySignature(forkVersion); err != nil {
+        t.Error(err)
+    }
+    if err := depositData.VerifySignature(types.ForkVersion{}); err == nil {
+        t.Error("Deposit signature was verified for an incorrect fork version")
+    }
+    if err := types.VerifyDepositSignature(validatorPubkey, validator.GetWithdrawalCredentials(), types.DepositAmount - 1, validatorSignature, forkVersion); err == nil {
+        t.Error("Deposit signature was verified for an incorrect amount")
+    }
+    if err := types.VerifyDepositSignature(validatorPubkey, common.HexToHash("0x01"), types.DepositAmount, validatorSignature, forkVersion); err == nil {
+        t.Error("Deposit signature was verified for incorrect withdrawal credentials")
+    }
+
+    // Verify malformed signature
+    invalidSignature := validatorSignature
+    invalidSignature[0] &= 0x7f
+    if err := types.VerifyDepositSignature(validatorPubkey, validator.GetWithdrawalCredentials(), types.DepositAmount, invalidSignature, forkVersion); err == nil {
+        t.Error("Uncompressed deposit signature was verified")
+    }
+
+}
+
+
+func TestParseDepositDataFile(t *testing.T) {
+
+    // Get deposit data
+    validatorPubkey, err := validator.GetValidatorPubkey()
+    if err != nil { t.Fatal(err) }
+    validatorSignature, err := validator.GetValidatorSignature()
+    if err != nil { t.Fatal(err) }
+    forkVersion, err := validator.GetDepositForkVersion()
+    if err != nil { t.Fatal(err) }
+    depositData := types.DepositData{
+        PublicKey: validatorPubkey,
+        WithdrawalCredentials: validator.GetWithdrawalCredentials(),
+        Amount: types.DepositAmount,
+        Signature: validatorSignature,
+    }
+    depositMessageRoot, err := depositData.MessageRoot()
+    if err != nil { t.Fatal(err) }
+    depositDataRoot, err := depositData.HashTreeRoot()
+    if err != nil { t.Fatal(err) }
+
+    // Get deposit data file contents
+    getFileData := func(messageRoot, dataRoot string) []byte {
+        return []byte(fmt.Sprintf(
+            `[{"pubkey": "%s", "withdrawal_credentials": "%s", "amount": %d, "signature": "%s", "deposit_message_root": "%s", "deposit_data_root": "%s", "fork_version": "%s", "eth2_network_name": "pyrmont", "deposit_cli_version": "1.1.0"}]`,
+            validatorPubkey.Hex(), validator.GetWithdrawalCredentials().Hex()[2:], types.DepositAmount, validatorSignature.Hex(), messageRoot, dataRoot, forkVersion.Hex(),
+        ))
+    }
+
+    // Parse deposit data file
+    entries, err := types.ParseDepositDataFile(getFileData(depositMessageRoot.Hex()[2:], depositDataRoot.Hex()[2:]))
+    if err != nil { t.Fatal(err) }
+    if len(entries) != 1 {
+        t.Fatalf("Incorrect deposit data file entry count %d", len(entries))
+    }
+    entry := entries[0]
+    if entry.DepositData != depositData {
+        t.Errorf("Incorrect deposit data file entry deposit data %v", entry.DepositData)
+    }
+    if entry.DepositMessageRoot != depositMessageRoot {
+        t.Errorf("Incorrect deposit data file entry deposit message root %s", entry.DepositMessageRoot.Hex())
+    }
+    if entry.DepositDataRoot != depositDataRoot {
+        t.Errorf("Incorrect deposit data file entry deposit data root %s", entry.DepositDataRoot.Hex())
+    }
+    if entry.ForkVersion != forkVersion {
+        t.Errorf("Incorrect deposit data file entry fork version %s", entry.ForkVersion.Hex())
+    }
+    if entry.NetworkName != "pyrmont" {
+        t.Errorf("Incorrect deposit data file entry network name %s", entry.NetworkName)
+    }
+    if err := entry.VerifySignature(entry.ForkVersion); err != nil {
+        t.Error(err)
+    }
+
+    // Parse deposit data files with invalid roots
+    if _, err := types.ParseDepositDataFile(getFileData(depositDataRoot.Hex()[2:], depositDataRoot.Hex()[2:])); err == nil {
+        t.Error("Parsed deposit data file with an incorrect deposit message root")
+    }
+    if _, err := types.ParseDepositDataFile(getFileData(depositMessageRoot.Hex()[2:], depositMessageRoot.Hex()[2:])); err == nil {
+        t.Error("Parsed deposit data file with an incorrect deposit data root")
+    }
+    if _, err := types.ParseDepositDataFile(getFileData(depositMessageRoot.Hex()[2:], "01")); err == nil {
+        t.Error("Parsed deposit data file with a malformed deposit data root")
+    }
+
+}
+
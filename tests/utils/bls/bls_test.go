@@ -0,0 +1,63 @@
+package bls
+
+import (
+    "testing"
+
+    blst "github.com/supranational/blst/bindings/go"
+
+    "github.com/rocket-pool/rocketpool-go/utils/bls"
+)
+
+
+func TestVerify(t *testing.T) {
+
+    // Generate key
+    secretKey := blst.KeyGen([]byte("rocketpool-go bls test key material"))
+    publicKey := new(blst.P1Affine).From(secretKey).Compress()
+
+    // Sign message
+    message := []byte("message")
+    signature := new(blst.P2Affine).Sign(secretKey, message, []byte(bls.SignatureDST)).Compress()
+
+    // Verify signature
+    if valid, err := bls.Verify(publicKey, message, signature); err != nil {
+        t.Fatal(err)
+    } else if !valid {
+        t.Error("Valid signature failed verification")
+    }
+
+    // Verify signature over a different message
+    if valid, err := bls.Verify(publicKey, []byte("other message"), signature); err != nil {
+        t.Fatal(err)
+    } else if valid {
+        t.Error("Signature over a different message passed verification")
+    }
+
+    // Verify signature with a different domain
+    otherSignature := new(blst.P2Affine).Sign(secretKey, message, []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_NUL_")).Compress()
+    if valid, err := bls.Verify(publicKey, message, otherSignature); err != nil {
+        t.Fatal(err)
+    } else if valid {
+        t.Error("Signature with a different domain passed verification")
+    }
+
+    // Verify malformed inputs
+    if _, err := bls.Verify(publicKey[1:], message, signature); err == nil {
+        t.Error("Public key with an invalid length passed verification")
+    }
+    if _, err := bls.Verify(publicKey, message, signature[1:]); err == nil {
+        t.Error("Signature with an invalid length passed verification")
+    }
+    infinityPublicKey := make([]byte, bls.PublicKeyLength)
+    infinityPublicKey[0] = 0xc0
+    if _, err := bls.Verify(infinityPublicKey, message, signature); err == nil {
+        t.Error("Public key at infinity passed verification")
+    }
+    invalidSignature := make([]byte, bls.SignatureLength)
+    invalidSignature[0] = 0x80
+    if _, err := bls.Verify(publicKey, message, invalidSignature); err == nil {
+        t.Error("Malformed signature passed verification")
+    }
+
+}
+
@@ -95,3 +95,48 @@ func (v *ValidatorSignature) UnmarshalJSON(data []byte) error {
     return err
 }
 
+
+// Beacon chain fork version
+const ForkVersionLength = 4 // bytes
+type ForkVersion [ForkVersionLength]byte
+
+
+// Bytes conversion
+func (v ForkVersion) Bytes() []byte {
+    return v[:]
+}
+func BytesToForkVersion(value []byte) ForkVersion {
+    var version ForkVersion
+    copy(version[:], value)
+    return version
+}
+
+
+// String conversion
+func (v ForkVersion) Hex() string {
+    return hex.EncodeToString(v.Bytes())
+}
+func (v ForkVersion) String() string {
+    return v.Hex()
+}
+func HexToForkVersion(value string) (ForkVersion, error) {
+    version := make([]byte, ForkVersionLength)
+    if _, err := hex.Decode(version, []byte(value)); err != nil {
+        return ForkVersion{}, err
+    }
+    return BytesToForkVersion(version), nil
+}
+
+
+// JSON encoding
+func (v ForkVersion) MarshalJSON() ([]byte, error) {
+    return json.Marshal(v.Hex())
+}
+func (v *ForkVersion) UnmarshalJSON(data []byte) error {
+    var dataStr string
+    if err := json.Unmarshal(data, &dataStr); err != nil { return err }
+    version, err := HexToForkVersion(dataStr)
+    if err == nil { *v = version }
+    return err
+}
+
@@ -0,0 +1,198 @@
+package types
+
+import (
+    "bytes"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "io/ioutil"
+    "strings"
+
+    "github.com/ethereum/go-ethereum/common"
+)
+
+
+// Deposit data file entry, as written to deposit_data-*.json by the eth2 deposit CLI
+type DepositDataFileEntry struct {
+    DepositData
+    DepositMessageRoot common.Hash          `json:"depositMessageRoot"`
+    DepositDataRoot common.Hash             `json:"depositDataRoot"`
+    ForkVersion ForkVersion                 `json:"forkVersion"`
+    NetworkName string                      `json:"networkName"`
+}
+
+
+// Raw deposit data file entry
+type depositDataFileEntryRaw struct {
+    PublicKey string                        `json:"pubkey"`
+    WithdrawalCredentials string            `json:"withdrawal_credentials"`
+    Amount uint64                           `json:"amount"`
+    Signature string                        `json:"signature"`
+    DepositMessageRoot string               `json:"deposit_message_root"`
+    DepositDataRoot string                  `json:"deposit_data_root"`
+    ForkVersion string                      `json:"fork_version"`
+    NetworkName string                      `json:"network_name"`
+    Eth2NetworkName string                  `json:"eth2_network_name"`
+}
+
+
+// Load a deposit data file from disk
+func LoadDepositDataFile(path string) ([]DepositDataFileEntry, error) {
+    data, err := ioutil.ReadFile(path)
+    if err != nil {
+        return []DepositDataFileEntry{}, fmt.Errorf("Could not read deposit data file %s: %w", path, err)
+    }
+    entries, err := ParseDepositDataFile(data)
+    if err != nil {
+        return []DepositDataFileEntry{}, fmt.Errorf("Could not load deposit data file %s: %w", path, err)
+    }
+    return entries, nil
+}
+
+
+// Parse deposit data file contents
+// The deposit message & deposit data roots of each entry are checked against the entry's deposit data
+func ParseDepositDataFile(data []byte) ([]DepositDataFileEntry, error) {
+
+    // Decode raw entries
+    var rawEntries []depositDataFileEntryRaw
+    if err := json.Unmarshal(data, &rawEntries); err != nil {
+        return []DepositDataFileEntry{}, fmt.Errorf("Could not decode deposit data file: %w", err)
+    }
+
+    // Parse & check entries
+    entries := make([]DepositDataFileEntry, len(rawEntries))
+    for ei, rawEntry := range rawEntries {
+        entry, err := parseDepositDataFileEntry(rawEntry)
+        if err != nil {
+            return []DepositDataFileEntry{}, fmt.Errorf("Invalid deposit data file entry %d: %w", ei, err)
+        }
+        if err := entry.CheckRoots(); err != nil {
+            return []DepositDataFileEntry{}, fmt.Errorf("Invalid deposit data file entry %d: %w", ei, err)
+        }
+        entries[ei] = entry
+    }
+
+    // Return
+    return entries, nil
+
+}
+
+
+// Check the entry's deposit message & deposit data roots against its deposit data
+func (e DepositDataFileEntry) CheckRoots() error {
+
+    // Check deposit message root
+    messageRoot, err := e.MessageRoot()
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(messageRoot.Bytes(), e.DepositMessageRoot.Bytes()) {
+        return fmt.Errorf("Deposit message root %s does not match expected deposit message root %s", messageRoot.Hex(), e.DepositMessageRoot.Hex())
+    }
+
+    // Check deposit data root
+    dataRoot, err := e.HashTreeRoot()
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(dataRoot.Bytes(), e.DepositDataRoot.Bytes()) {
+        return fmt.Errorf("Deposit data root %s does not match expected deposit data root %s", dataRoot.Hex(), e.DepositDataRoot.Hex())
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Parse a raw deposit data file entry
+func parseDepositDataFileEntry(raw depositDataFileEntryRaw) (DepositDataFileEntry, error) {
+
+    // Check value lengths
+    if err := checkHexLength(raw.PublicKey, ValidatorPubkeyLength); err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid pubkey '%s': %w", raw.PublicKey, err)
+    }
+    if err := checkHexLength(raw.Signature, ValidatorSignatureLength); err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid signature '%s': %w", raw.Signature, err)
+    }
+    if err := checkHexLength(raw.ForkVersion, ForkVersionLength); err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid fork version '%s': %w", raw.ForkVersion, err)
+    }
+
+    // Parse values
+    pubkey, err := HexToValidatorPubkey(trimHexPrefix(raw.PublicKey))
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid pubkey '%s': %w", raw.PublicKey, err)
+    }
+    signature, err := HexToValidatorSignature(trimHexPrefix(raw.Signature))
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid signature '%s': %w", raw.Signature, err)
+    }
+    withdrawalCredentials, err := hexToHash(raw.WithdrawalCredentials)
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid withdrawal credentials '%s': %w", raw.WithdrawalCredentials, err)
+    }
+    depositMessageRoot, err := hexToHash(raw.DepositMessageRoot)
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid deposit message root '%s': %w", raw.DepositMessageRoot, err)
+    }
+    depositDataRoot, err := hexToHash(raw.DepositDataRoot)
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid deposit data root '%s': %w", raw.DepositDataRoot, err)
+    }
+    forkVersion, err := HexToForkVersion(trimHexPrefix(raw.ForkVersion))
+    if err != nil {
+        return DepositDataFileEntry{}, fmt.Errorf("Invalid fork version '%s': %w", raw.ForkVersion, err)
+    }
+
+    // Get network name
+    networkName := raw.NetworkName
+    if networkName == "" {
+        networkName = raw.Eth2NetworkName
+    }
+
+    // Return
+    return DepositDataFileEntry{
+        DepositData: DepositData{
+            PublicKey: pubkey,
+            WithdrawalCredentials: withdrawalCredentials,
+            Amount: raw.Amount,
+            Signature: signature,
+        },
+        DepositMessageRoot: depositMessageRoot,
+        DepositDataRoot: depositDataRoot,
+        ForkVersion: forkVersion,
+        NetworkName: networkName,
+    }, nil
+
+}
+
+
+// Decode a 32-byte hex string with an optional prefix
+func hexToHash(value string) (common.Hash, error) {
+    if err := checkHexLength(value, common.HashLength); err != nil {
+        return common.Hash{}, err
+    }
+    bytes, err := hex.DecodeString(trimHexPrefix(value))
+    if err != nil {
+        return common.Hash{}, err
+    }
+    return common.BytesToHash(bytes), nil
+}
+
+
+// Check the decoded length of a hex string with an optional prefix
+func checkHexLength(value string, length int) error {
+    if len(trimHexPrefix(value)) != length * 2 {
+        return fmt.Errorf("Invalid length %d bytes, expected %d", len(trimHexPrefix(value)) / 2, length)
+    }
+    return nil
+}
+
+
+// Remove a hex string prefix
+func trimHexPrefix(value string) string {
+    return strings.TrimPrefix(value, "0x")
+}
+
@@ -0,0 +1,171 @@
+package types
+
+import (
+    "bytes"
+    "errors"
+    "fmt"
+
+    "github.com/ethereum/go-ethereum/common"
+    "github.com/prysmaticlabs/go-ssz"
+
+    "github.com/rocket-pool/rocketpool-go/utils/bls"
+)
+
+
+// Validator deposit amount
+const DepositAmount = 32000000000 // gwei
+
+
+// Deposit signature domain type
+var DepositDomainType = [4]byte{0x03, 0x00, 0x00, 0x00}
+
+
+// Validator deposit data
+type DepositData struct {
+    PublicKey ValidatorPubkey               `json:"pubkey"`
+    WithdrawalCredentials common.Hash       `json:"withdrawalCredentials"`
+    Amount uint64                           `json:"amount"`
+    Signature ValidatorSignature            `json:"signature"`
+}
+
+
+// SSZ containers
+type depositMessageContainer struct {
+    PublicKey []byte                        `ssz-size:"48"`
+    WithdrawalCredentials []byte            `ssz-size:"32"`
+    Amount uint64
+}
+type depositDataContainer struct {
+    PublicKey []byte                        `ssz-size:"48"`
+    WithdrawalCredentials []byte            `ssz-size:"32"`
+    Amount uint64
+    Signature []byte                        `ssz-size:"96"`
+}
+type forkDataContainer struct {
+    CurrentVersion []byte                   `ssz-size:"4"`
+    GenesisValidatorsRoot []byte            `ssz-size:"32"`
+}
+type signingDataContainer struct {
+    ObjectRoot []byte                       `ssz-size:"32"`
+    Domain []byte                           `ssz-size:"32"`
+}
+
+
+// Get the deposit message root (deposit data without signature)
+func (d DepositData) MessageRoot() (common.Hash, error) {
+    root, err := ssz.HashTreeRoot(depositMessageContainer{
+        PublicKey: d.PublicKey.Bytes(),
+        WithdrawalCredentials: d.WithdrawalCredentials.Bytes(),
+        Amount: d.Amount,
+    })
+    if err != nil {
+        return common.Hash{}, fmt.Errorf("Could not get deposit message root: %w", err)
+    }
+    return root, nil
+}
+
+
+// Get the deposit data root
+func (d DepositData) HashTreeRoot() (common.Hash, error) {
+    root, err := ssz.HashTreeRoot(depositDataContainer{
+        PublicKey: d.PublicKey.Bytes(),
+        WithdrawalCredentials: d.WithdrawalCredentials.Bytes(),
+        Amount: d.Amount,
+        Signature: d.Signature.Bytes(),
+    })
+    if err != nil {
+        return common.Hash{}, fmt.Errorf("Could not get deposit data root: %w", err)
+    }
+    return root, nil
+}
+
+
+// Validate the deposit data against the expected withdrawal credentials and deposit data root
+func (d DepositData) Validate(withdrawalCredentials common.Hash, depositDataRoot common.Hash) error {
+
+    // Check withdrawal credentials
+    if !bytes.Equal(d.WithdrawalCredentials.Bytes(), withdrawalCredentials.Bytes()) {
+        return fmt.Errorf("Deposit data withdrawal credentials %s do not match expected withdrawal credentials %s", d.WithdrawalCredentials.Hex(), withdrawalCredentials.Hex())
+    }
+
+    // Check deposit data root
+    root, err := d.HashTreeRoot()
+    if err != nil {
+        return err
+    }
+    if !bytes.Equal(root.Bytes(), depositDataRoot.Bytes()) {
+        return fmt.Errorf("Deposit data root %s does not match expected deposit data root %s", root.Hex(), depositDataRoot.Hex())
+    }
+
+    // Return
+    return nil
+
+}
+
+
+// Get the deposit signing root for a beacon chain fork version
+// Deposits are signed over the genesis fork domain with a zero genesis validators root
+func (d DepositData) SigningRoot(forkVersion ForkVersion) (common.Hash, error) {
+
+    // Get deposit message root
+    messageRoot, err := d.MessageRoot()
+    if err != nil {
+        return common.Hash{}, err
+    }
+
+    // Get deposit domain
+    forkDataRoot, err := ssz.HashTreeRoot(forkDataContainer{
+        CurrentVersion: forkVersion.Bytes(),
+        GenesisValidatorsRoot: make([]byte, common.HashLength),
+    })
+    if err != nil {
+        return common.Hash{}, fmt.Errorf("Could not get deposit fork data root: %w", err)
+    }
+    domain := append(DepositDomainType[:], forkDataRoot[:common.HashLength - len(DepositDomainType)]...)
+
+    // Get signing root
+    signingRoot, err := ssz.HashTreeRoot(signingDataContainer{
+        ObjectRoot: messageRoot.Bytes(),
+        Domain: domain,
+    })
+    if err != nil {
+        return common.Hash{}, fmt.Errorf("Could not get deposit signing root: %w", err)
+    }
+    return signingRoot, nil
+
+}
+
+
+// Verify the deposit data signature for a beacon chain fork version
+func (d DepositData) VerifySignature(forkVersion ForkVersion) error {
+    return VerifyDepositSignature(d.PublicKey, d.WithdrawalCredentials, d.Amount, d.Signature, forkVersion)
+}
+
+
+// Verify a validator deposit signature for a beacon chain fork version
+func VerifyDepositSignature(pubkey ValidatorPubkey, withdrawalCredentials common.Hash, amount uint64, signature ValidatorSignature, forkVersion ForkVersion) error {
+
+    // Get signing root
+    signingRoot, err := DepositData{
+        PublicKey: pubkey,
+        WithdrawalCredentials: withdrawalCredentials,
+        Amount: amount,
+    }.SigningRoot(forkVersion)
+    if err != nil {
+        return err
+    }
+
+    // Verify signature
+    valid, err := bls.Verify(pubkey.Bytes(), signingRoot.Bytes(), signature.Bytes())
+    if err != nil {
+        return fmt.Errorf("Could not verify deposit signature for validator %s: %w", pubkey.Hex(), err)
+    }
+    if !valid {
+        return errors.New("Deposit signature is not valid for validator " + pubkey.Hex() + " on fork version " + forkVersion.Hex())
+    }
+
+    // Return
+    return nil
+
+}
+
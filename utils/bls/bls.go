@@ -0,0 +1,53 @@
+package bls
+
+import (
+    "errors"
+    "fmt"
+
+    blst "github.com/supranational/blst/bindings/go"
+)
+
+
+// Signature scheme settings
+const (
+    PublicKeyLength = 48 // bytes
+    SignatureLength = 96 // bytes
+    SignatureDST = "BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_"
+)
+
+
+// Verify a BLS signature over a message with a public key
+// Public keys are compressed G1 points and signatures are compressed G2 points, as used in eth2
+func Verify(publicKey []byte, message []byte, signature []byte) (bool, error) {
+
+    // Check lengths
+    if len(publicKey) != PublicKeyLength {
+        return false, fmt.Errorf("Invalid public key length %d", len(publicKey))
+    }
+    if len(signature) != SignatureLength {
+        return false, fmt.Errorf("Invalid signature length %d", len(signature))
+    }
+
+    // Decode & check public key
+    pubkeyPoint := new(blst.P1Affine).Uncompress(publicKey)
+    if pubkeyPoint == nil {
+        return false, errors.New("Invalid public key: could not decompress point")
+    }
+    if !pubkeyPoint.KeyValidate() {
+        return false, errors.New("Invalid public key: point is at infinity or not in the correct subgroup")
+    }
+
+    // Decode & check signature
+    signaturePoint := new(blst.P2Affine).Uncompress(signature)
+    if signaturePoint == nil {
+        return false, errors.New("Invalid signature: could not decompress point")
+    }
+    if !signaturePoint.SigValidate(false) {
+        return false, errors.New("Invalid signature: point is not in the correct subgroup")
+    }
+
+    // Verify
+    return signaturePoint.Verify(false, pubkeyPoint, false, message, []byte(SignatureDST)), nil
+
+}
+
@@ -0,0 +1,33 @@
+package eth
+
+import (
+    "context"
+    "math/big"
+
+    "github.com/ethereum/go-ethereum/accounts/abi/bind"
+    "github.com/ethereum/go-ethereum/ethclient"
+)
+
+
+// Get call options pinned to a single block
+// Pins to the latest block if no block number is specified
+func PinCallOpts(client *ethclient.Client, opts *bind.CallOpts) (*bind.CallOpts, error) {
+
+    // Copy call options
+    pinned := &bind.CallOpts{}
+    if opts != nil { *pinned = *opts }
+
+    // Set block number
+    if pinned.BlockNumber == nil {
+        header, err := client.HeaderByNumber(context.Background(), nil)
+        if err != nil {
+            return nil, err
+        }
+        pinned.BlockNumber = new(big.Int).Set(header.Number)
+    }
+
+    // Return
+    return pinned, nil
+
+}
+